@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+
+	c "github.com/andreygrechin/gosemver/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// batchMaxLineSize is the longest input line accepted in '--batch' mode.
+const batchMaxLineSize = 1024 * 1024
+
+var batchMode bool
+
+type batchJob struct {
+	line   int
+	input  string
+	result chan outcome
+}
+
+// addBatchFlag adds the '--batch' flag to a command.
+func addBatchFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&batchMode, "batch", false,
+		`Process every line of stdin, printing one result per line in input order and a summary to stderr`)
+}
+
+// batchArgs expects n arguments in '--batch' mode and uses args otherwise.
+func batchArgs(n int, args cobra.PositionalArgs) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, a []string) error {
+		if batchMode {
+			return cobra.ExactArgs(n)(cmd, a)
+		}
+
+		return args(cmd, a)
+	}
+}
+
+// runBatch processes the non-empty lines of stdin concurrently with process, prints the outcomes
+// in input order and exits with the most severe exit code of all lines.
+func runBatch(cmd *cobra.Command, process func(input string) outcome) {
+	if inputFile != "" {
+		exitWithResult(newResult(), "Error: The '--batch' flag cannot be used with the '--file' flag", c.ExitOtherErrors)
+	}
+
+	workers := runtime.GOMAXPROCS(0)
+	jobs := make(chan batchJob)
+	pending := make(chan chan outcome, workers*2) //nolint:mnd
+	readErr := make(chan error, 1)
+
+	for range workers {
+		go func() {
+			for job := range jobs {
+				o := process(job.input)
+				o.res.Line = job.line
+				job.result <- o
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		defer close(pending)
+
+		scanner := bufio.NewScanner(cmd.InOrStdin())
+		scanner.Buffer(nil, batchMaxLineSize)
+
+		for line := 1; scanner.Scan(); line++ {
+			input := strings.TrimSpace(scanner.Text())
+			if input == "" {
+				continue
+			}
+
+			result := make(chan outcome, 1)
+			pending <- result
+			jobs <- batchJob{line: line, input: input, result: result}
+		}
+
+		readErr <- scanner.Err()
+	}()
+
+	var total, invalid, errs int
+
+	exitCode := c.ExitOK
+
+	for result := range pending {
+		code := printBatchOutcome(<-result)
+
+		total++
+
+		switch code {
+		case c.ExitOK:
+		case c.ExitInvalidSemver:
+			invalid++
+		default:
+			errs++
+		}
+
+		exitCode = max(exitCode, code)
+	}
+
+	if err := <-readErr; err != nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to read stdin: %v\n", err)
+
+		exitCode = c.ExitOtherErrors
+	}
+
+	fmt.Fprintf(os.Stderr, "%d lines: %d ok, %d invalid, %d errors\n", total, total-invalid-errs, invalid, errs)
+	os.Exit(exitCode)
+}
+
+// printBatchOutcome prints the outcome of one line and returns its exit code. In text and template
+// output failures are printed to stderr prefixed with the line number, in json and yaml output every
+// line is printed as an object.
+func printBatchOutcome(o outcome) int {
+	if outputFormat == outputYAML {
+		fmt.Println("---")
+	}
+
+	if o.code != c.ExitOK && outputFormat != outputJSON && outputFormat != outputYAML {
+		fmt.Fprintf(os.Stderr, "line %d: %s\n", o.res.Line, o.message)
+
+		return o.code
+	}
+
+	if err := writeResult(o.res, o.text); err != nil {
+		fmt.Fprintf(os.Stderr, "line %d: Error: %v\n", o.res.Line, err)
+
+		return c.ExitOtherErrors
+	}
+
+	return o.code
+}
@@ -1,9 +1,8 @@
 package cmd
 
 import (
-	"errors"
 	"fmt"
-	"os"
+	"strings"
 
 	c "github.com/andreygrechin/gosemver/internal/config"
 	"github.com/andreygrechin/gosemver/pkg/gosemver"
@@ -21,52 +20,83 @@ var bumpCmd = &cobra.Command{
 	Long: `Increment specific a semantic version identifier <semver_id> of a provided semantic
 version <version> where identifier is (major|minor|patch|prerelease|build|release).
 
-The version can be provided either as an argument or via stdin when using '-' as the argument.
-Only one input method can be used at a time.
+The version can be provided either as an argument, via stdin when using '-' as the argument, or read
+from a project file with '--file'. Only one input method can be used at a time. With '--write' the
+bumped version is written back to the file, keeping the rest of it untouched. With '--batch' every
+line of stdin is bumped.
+
+Bumping the prerelease of a release without '--prerelease' starts the first of the configured
+prerelease stages, if any.
+
+Bumping the major version of a version read from a go.mod file with '--file' fails when the module
+path would need a new '/vN' suffix, see 'gosemver gomod check'. Use '--allow-major' to bump anyway,
+e.g. before renaming the module.
 
 Examples:
   gosemver bump major 0.1.2
   gosemver bump prerelease 2.0.0 --prerelease beta
   gosemver bump prerelease 2.0.0-beta
+  gosemver bump minor --file package.json --write
+  gosemver bump minor 1.2.3 --template 'v{{.Major}}.{{.Minor}}'
+  gosemver bump patch --batch < versions.txt
 `,
-	Args: cobra.ExactArgs(2), //nolint:mnd
+	Args: batchArgs(1, argsWithFile(2)), //nolint:mnd
 	Run: func(cmd *cobra.Command, args []string) {
 		semverID := args[0]
-		version, err := gosemver.GetLastArg(*cmd, args)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Failed to get arguments: %v\n", err)
-			os.Exit(c.ExitOtherErrors)
-		}
-		if version == "" {
-			fmt.Fprintln(os.Stderr, "Error: version string is empty")
-			os.Exit(c.ExitOtherErrors)
-		}
 		if semverID != "prerelease" && newPrereleaseID != "" {
-			fmt.Fprintf(os.Stderr, "Error: The '--prerelease' flag can only be used with the 'prerelease' identifier\n")
-			os.Exit(c.ExitOtherErrors)
+			exitWithResult(newResult(), "Error: The '--prerelease' flag can only be used with the 'prerelease' identifier",
+				c.ExitOtherErrors)
 		}
 		if semverID != "build" && newBuildID != "" {
-			fmt.Fprintf(os.Stderr, "Error: The '--build' flag can only be used with the 'build' identifier\n")
-			os.Exit(c.ExitOtherErrors)
+			exitWithResult(newResult(), "Error: The '--build' flag can only be used with the 'build' identifier",
+				c.ExitOtherErrors)
+		}
+		bump := func(version string) outcome { return bumpVersion(semverID, version) }
+		if batchMode {
+			runBatch(cmd, bump)
 		}
-		semVer, err := gosemver.BumpSemVer(semverID, version, newPrereleaseID, newBuildID)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-			if errors.Is(err, gosemver.ErrInvalidVersion) {
-				os.Exit(c.ExitInvalidSemver)
+
+		version := inputVersion(cmd, args)
+		o := bump(version)
+		if o.code == c.ExitOK && semverID == gosemver.Major && !allowMajor && isModFile(inputFile) {
+			path := gosemver.ParseVersionFile(inputFile).Path
+			if err := checkBumpedModule(path, o.res.Version, o.res.data); err != nil {
+				o.res.Result = nil
+				exitWithResult(o.res, fmt.Sprintf("Error: %v, use '--allow-major' to bump anyway", err),
+					c.ExitInvalidSemver)
 			}
-			os.Exit(c.ExitOtherErrors)
 		}
-		if !gosemver.IsSemVer(semVer.String()) {
-			fmt.Fprintf(os.Stderr, "Error: we get an invalid semantic version after bump: %s\n", semVer)
-			os.Exit(c.ExitInvalidSemver)
+		if o.code == c.ExitOK && writeFile {
+			writeVersion(version[:len(version)-len(strings.TrimLeft(version, "vV"))] + o.text)
 		}
-		fmt.Println(semVer)
+		exitWithOutcome(o)
 	},
 }
 
+func bumpVersion(semverID, version string) outcome {
+	res := newResult(version)
+	prereleaseID := newPrereleaseID
+	if semverID == gosemver.Prerelease && prereleaseID == "" && res.Version != nil && res.Version.Prerelease == "" {
+		prereleaseID = defaultPrereleaseID()
+	}
+	semVer, err := gosemver.BumpSemVer(semverID, version, prereleaseID, newBuildID)
+	if err != nil {
+		return failedWithError(res, err)
+	}
+	if !gosemver.IsSemVer(semVer.String()) {
+		return failed(res, fmt.Sprintf("Error: we get an invalid semantic version after bump: %s", semVer),
+			c.ExitInvalidSemver)
+	}
+	res.Result = semVer.String()
+	res.data = semVer
+
+	return succeeded(res, semVer.String())
+}
+
 func init() {
 	rootCmd.AddCommand(bumpCmd)
+	addFileFlag(bumpCmd, true)
+	addBatchFlag(bumpCmd)
 	bumpCmd.PersistentFlags().StringVarP(
 		&newPrereleaseID,
 		"prerelease",
@@ -74,6 +104,12 @@ func init() {
 		"",
 		`Add or replace a new prerelease ID, valid only with the 'prerelease' SemVer identifier`,
 	)
+	bumpCmd.Flags().BoolVar(
+		&allowMajor,
+		"allow-major",
+		false,
+		`Bump the major version even if the Go module path does not allow it`,
+	)
 	bumpCmd.PersistentFlags().StringVarP(
 		&newBuildID,
 		"build",
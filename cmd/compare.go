@@ -2,9 +2,7 @@
 package cmd
 
 import (
-	"errors"
 	"fmt"
-	"os"
 	"strings"
 
 	c "github.com/andreygrechin/gosemver/internal/config"
@@ -24,52 +22,50 @@ var compareCmd = &cobra.Command{
 higher, 0 if equal, 1 if lower. Build identifiers of versions is always ignored.
 
 The versions can be provided either as two arguments or via stdin when using '-' as the argument. In that case,
-versions should be separated by a space. Only one input method can be used at a time.
+versions should be separated by a space. Only one input method can be used at a time. With '--batch' every
+line of stdin holding two versions separated by whitespace is processed.
 
 Examples:
   gosemver compare v0.1.2 v0.1.2-beta1
   gosemver compare v0.1.2 v0.1.2+build1
 `,
-	Args: cobra.RangeArgs(compareNArgsStdin, compareNArgs),
+	Args: batchArgs(0, cobra.RangeArgs(compareNArgsStdin, compareNArgs)),
 	Run: func(cmd *cobra.Command, args []string) {
-		var (
-			compareResult    int
-			compareResultErr error
-		)
-		if len(args) == compareNArgs {
-			version := args[0]
-			otherVersion := args[1]
-			compareResult, compareResultErr = gosemver.CompareSemVer(version, otherVersion)
+		if batchMode {
+			runBatch(cmd, func(input string) outcome { return compareVersions(strings.Fields(input)) })
 		}
+
+		versions := args
 		if len(args) == compareNArgsStdin {
-			versions, err := gosemver.GetLastArg(*cmd, args)
+			input, err := gosemver.GetLastArg(*cmd, args)
 			if err != nil {
-				fmt.Fprintf(os.Stderr, "Failed to get arguments: %v\n", err)
-				os.Exit(c.ExitOtherErrors)
-			}
-			if versions == "" {
-				fmt.Fprintln(os.Stderr, "Error: versions string is empty")
-				os.Exit(c.ExitOtherErrors)
-			}
-			versionsSlice := strings.Split(versions, " ")
-			if len(versionsSlice) != compareNArgs {
-				fmt.Fprintln(os.Stderr, "Error: two versions should be provided")
-				os.Exit(c.ExitOtherErrors)
+				exitWithResult(newResult(), fmt.Sprintf("Failed to get arguments: %v", err), c.ExitOtherErrors)
 			}
-
-			compareResult, compareResultErr = gosemver.CompareSemVer(versionsSlice[0], versionsSlice[1])
-		}
-		if compareResultErr != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", compareResultErr)
-			if errors.Is(compareResultErr, gosemver.ErrInvalidVersion) {
-				os.Exit(c.ExitInvalidSemver)
+			if input == "" {
+				exitWithResult(newResult(), "Error: versions string is empty", c.ExitOtherErrors)
 			}
-			os.Exit(c.ExitOtherErrors)
+			versions = strings.Split(input, " ")
 		}
-		fmt.Println(compareResult)
+
+		exitWithOutcome(compareVersions(versions))
 	},
 }
 
+func compareVersions(versions []string) outcome {
+	res := newResult(versions...)
+	if len(versions) != compareNArgs {
+		return failed(res, "Error: two versions should be provided", c.ExitOtherErrors)
+	}
+	compareResult, err := gosemver.CompareSemVer(versions[0], versions[1])
+	if err != nil {
+		return failedWithError(res, err)
+	}
+	res.Result = compareResult
+
+	return succeeded(res, fmt.Sprint(compareResult))
+}
+
 func init() {
 	rootCmd.AddCommand(compareCmd)
+	addBatchFlag(compareCmd)
 }
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	c "github.com/andreygrechin/gosemver/internal/config"
+	"github.com/andreygrechin/gosemver/internal/git"
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+	"github.com/spf13/cobra"
+)
+
+var componentsCmd = &cobra.Command{
+	Use:   "components",
+	Short: "List monorepo components with their current versions",
+	Long: `List components of a monorepo tagged as '<path>/<prefix><version>', e.g. 'services/api/v1.4.0',
+with the latest version of each among tags reachable from HEAD. Outputs one tab-separated
+'<path> <version>' pair per line, sorted by path.
+
+Examples:
+  gosemver components
+  gosemver components --tag-prefix release-
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		tags, err := git.Repo{}.MergedTags()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(c.ExitOtherErrors)
+		}
+
+		for _, component := range gosemver.LatestComponents(tags, tagPrefix) {
+			fmt.Printf("%s\t%s\n", component.Path, component.Version)
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(componentsCmd)
+	componentsCmd.Flags().StringVar(
+		&tagPrefix,
+		"tag-prefix",
+		"v",
+		`Prefix of version tags`,
+	)
+}
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"fmt"
+
+	c "github.com/andreygrechin/gosemver/internal/config"
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+	"github.com/spf13/cobra"
+)
+
+const (
+	schemePEP440 = "pep440"
+	schemeSemVer = "semver"
+)
+
+var convertTo string
+
+var convertCmd = &cobra.Command{
+	Use:   "convert <version|->",
+	Short: "Convert a version between SemVer and PEP 440",
+	Long: `Convert a version between Semantic Versioning and PEP 440, the versioning scheme of Python packages.
+With '--to pep440' a semantic version is converted, with '--to semver' a PEP 440 version. Exits with
+status 1 if the version is invalid, or 2 if it cannot be converted without changing its meaning.
+
+  SemVer          PEP 440
+  1.2.3-alpha.1   1.2.3a1
+  1.2.3-beta.2    1.2.3b2
+  1.2.3-rc.1      1.2.3rc1
+
+SemVer prereleases must be alpha, beta or rc and a number, like 'rc.1', as other spellings like
+'rc10' order differently in SemVer. PEP 440 epochs like '1!2.0', post-releases like '1.2.3.post2',
+developmental releases like '1.2.3.dev4', local versions like '1.2.3+local.7' and releases with more
+than three numbers cannot be converted, as SemVer cannot order them the same way. For the same reason
+SemVer build metadata is not converted to a local version.
+
+The version can be provided either as an argument, via stdin when using '-' as the argument, or read
+from a project file with '--file'. Only one input method can be used at a time. With '--batch' every
+line of stdin is converted.
+
+Examples:
+  gosemver convert --to pep440 1.2.3-rc.1
+  gosemver convert --to semver 1.2.3rc1
+  gosemver convert --to semver --file pyproject.toml
+`,
+	Args: batchArgs(0, argsWithFile(1)),
+	Run: func(cmd *cobra.Command, args []string) {
+		if convertTo != schemePEP440 && convertTo != schemeSemVer {
+			exitWithResult(newResult(), fmt.Sprintf("Error: unknown versioning scheme %q, expected pep440 or semver",
+				convertTo), c.ExitOtherErrors)
+		}
+
+		if batchMode {
+			runBatch(cmd, convertVersion)
+		}
+
+		exitWithOutcome(convertVersion(inputVersion(cmd, args)))
+	},
+}
+
+func convertVersion(version string) outcome {
+	res := newResult(version)
+
+	if convertTo == schemePEP440 {
+		ver, err := gosemver.ParseSemVer(version)
+		if err != nil {
+			return failedWithError(res, err)
+		}
+
+		converted, err := gosemver.PEP440FromSemVer(ver)
+		if err != nil {
+			return failedWithError(res, err)
+		}
+		res.Result = converted.String()
+
+		return succeeded(res, converted.String())
+	}
+
+	parsed, err := gosemver.ParsePEP440(version)
+	if err != nil {
+		return failed(res, fmt.Sprintf("Error: %v", err), c.ExitInvalidSemver)
+	}
+
+	converted, err := parsed.SemVer()
+	if err != nil {
+		return failedWithError(res, err)
+	}
+	res.Version, res.data = converted, converted
+	res.Result = converted.String()
+
+	return succeeded(res, converted.String())
+}
+
+func init() {
+	rootCmd.AddCommand(convertCmd)
+	addFileFlag(convertCmd, false)
+	addBatchFlag(convertCmd)
+	convertCmd.Flags().StringVar(&convertTo, "to", "", `Versioning scheme to convert to: pep440 or semver`)
+	_ = convertCmd.MarkFlagRequired("to")
+}
@@ -2,9 +2,7 @@
 package cmd
 
 import (
-	"errors"
 	"fmt"
-	"os"
 	"strings"
 
 	c "github.com/andreygrechin/gosemver/internal/config"
@@ -24,54 +22,50 @@ var diffCmd = &cobra.Command{
 output the identifier to stdout.
 
 The versions can be provided either as two arguments or via stdin when using '-' as the argument. In that case,
-versions should be separated by a space. Only one input method can be used at a time.
+versions should be separated by a space. Only one input method can be used at a time. With '--batch' every
+line of stdin holding two versions separated by whitespace is processed.
 
 Examples:
   gosemver diff v0.1.2 v0.2.2
   gosemver diff v0.1.2 v0.1.2-beta1
 `,
-	Args: cobra.RangeArgs(diffNArgsStdin, diffNArgs),
+	Args: batchArgs(0, cobra.RangeArgs(diffNArgsStdin, diffNArgs)),
 	Run: func(cmd *cobra.Command, args []string) {
-		var (
-			diffResult    string
-			diffResultErr error
-		)
-		if len(args) == diffNArgs {
-			version := args[0]
-			otherVersion := args[1]
-			diffResult, diffResultErr = gosemver.CommandDiff(version, otherVersion)
+		if batchMode {
+			runBatch(cmd, func(input string) outcome { return diffVersions(strings.Fields(input)) })
 		}
 
+		versions := args
 		if len(args) == diffNArgsStdin {
-			versions, err := gosemver.GetLastArg(*cmd, args)
+			input, err := gosemver.GetLastArg(*cmd, args)
 			if err != nil {
-				fmt.Fprintf(os.Stderr, "Failed to get arguments: %v\n", err)
-				os.Exit(c.ExitOtherErrors)
+				exitWithResult(newResult(), fmt.Sprintf("Failed to get arguments: %v", err), c.ExitOtherErrors)
 			}
-			if versions == "" {
-				fmt.Fprintln(os.Stderr, "Error: versions string is empty")
-				os.Exit(c.ExitOtherErrors)
+			if input == "" {
+				exitWithResult(newResult(), "Error: versions string is empty", c.ExitOtherErrors)
 			}
-			versionsSlice := strings.Split(versions, " ")
-			if len(versionsSlice) != diffNArgs {
-				fmt.Fprintln(os.Stderr, "Error: two versions should be provided")
-				os.Exit(c.ExitOtherErrors)
-			}
-
-			diffResult, diffResultErr = gosemver.CommandDiff(versionsSlice[0], versionsSlice[1])
-		}
-		if diffResultErr != nil {
-			fmt.Fprintf(os.Stderr, "Error: %v\n", diffResultErr)
-			if errors.Is(diffResultErr, gosemver.ErrInvalidVersion) {
-				os.Exit(c.ExitInvalidSemver)
-			}
-			os.Exit(c.ExitOtherErrors)
+			versions = strings.Split(input, " ")
 		}
 
-		fmt.Println(diffResult)
+		exitWithOutcome(diffVersions(versions))
 	},
 }
 
+func diffVersions(versions []string) outcome {
+	res := newResult(versions...)
+	if len(versions) != diffNArgs {
+		return failed(res, "Error: two versions should be provided", c.ExitOtherErrors)
+	}
+	diffResult, err := gosemver.CommandDiff(versions[0], versions[1])
+	if err != nil {
+		return failedWithError(res, err)
+	}
+	res.Result = diffResult
+
+	return succeeded(res, fmt.Sprint(diffResult))
+}
+
 func init() {
 	rootCmd.AddCommand(diffCmd)
+	addBatchFlag(diffCmd)
 }
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	c "github.com/andreygrechin/gosemver/internal/config"
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+	"github.com/spf13/cobra"
+)
+
+var existingTagsFile string
+
+var dockerTagsCmd = &cobra.Command{
+	Use:   "docker-tags <version|->",
+	Short: "Compute the image tags for a release",
+	Long: `Compute the image tags for a release: the version itself and the floating tags '<major>.<minor>',
+'<major>' and 'latest' for which <version> is the highest version. Versions already released are read from
+'--existing', one per line, or '-' for stdin; tags which are not semantic versions and prereleases are
+ignored. Prereleases never move floating tags. Build metadata is made OCI compatible, e.g. '+' becomes '-'.
+Outputs one tag per line.
+
+Examples:
+  gosemver docker-tags 1.4.2
+  gosemver docker-tags 1.4.2 --existing tags.txt
+  skopeo list-tags docker://ghcr.io/org/app | jq -r '.Tags[]' | gosemver docker-tags 1.4.2 --existing -
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		version := args[0]
+		if version == "-" && existingTagsFile == "-" {
+			exitWithResult(newResult(), "Error: the version and '--existing' cannot both be read from stdin",
+				c.ExitOtherErrors)
+		}
+		version = inputVersion(cmd, args)
+		res := newResult(version)
+
+		var existing []string
+		if existingTagsFile != "" {
+			var err error
+			existing, err = readLines(cmd, existingTagsFile)
+			if err != nil {
+				exitWithResult(res, fmt.Sprintf("Failed to read existing tags: %v", err), c.ExitOtherErrors)
+			}
+		}
+
+		tags, err := gosemver.DockerTags(version, existing)
+		if err != nil {
+			exitWithError(res, err)
+		}
+		res.Result = tags
+		printResult(res, strings.Join(tags, "\n"))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(dockerTagsCmd)
+	dockerTagsCmd.Flags().StringVar(
+		&existingTagsFile,
+		"existing",
+		"",
+		`File with the versions already released, one per line, or '-' for stdin`,
+	)
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	c "github.com/andreygrechin/gosemver/internal/config"
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+	"github.com/spf13/cobra"
+)
+
+var (
+	inputFile string
+	writeFile bool
+)
+
+const fileFlagUsage = `Read the version from a file as '<path>[:<selector>]', the selector is a JSON pointer ` +
+	`(package.json:/version), a dotted YAML or TOML key (Cargo.toml:package.version) or a regular expression ` +
+	`with a capture group (main.go:regex:Version = "(.+)")`
+
+// addFileFlag adds the '--file' flag and, if write is set, the '--write' flag to a command.
+func addFileFlag(cmd *cobra.Command, write bool) {
+	cmd.Flags().StringVarP(&inputFile, "file", "f", "", fileFlagUsage)
+
+	if write {
+		cmd.Flags().BoolVarP(&writeFile, "write", "w", false, `Write the result back to the file given by '--file'`)
+	}
+}
+
+// argsWithFile expects n arguments, or n-1 if the version is read from a file.
+func argsWithFile(n int) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if inputFile != "" {
+			return cobra.ExactArgs(n-1)(cmd, args)
+		}
+
+		return cobra.ExactArgs(n)(cmd, args)
+	}
+}
+
+// inputVersion returns the version from the file given by '--file' or the last argument.
+func inputVersion(cmd *cobra.Command, args []string) string {
+	if writeFile && inputFile == "" {
+		exitWithResult(newResult(), "Error: The '--write' flag requires the '--file' flag", c.ExitOtherErrors)
+	}
+
+	var (
+		version string
+		err     error
+	)
+
+	if inputFile != "" {
+		version, err = gosemver.ParseVersionFile(inputFile).Read()
+		if err != nil {
+			exitWithResult(newResult(), fmt.Sprintf("Failed to read version: %v", err), c.ExitOtherErrors)
+		}
+	} else {
+		version, err = gosemver.GetLastArg(*cmd, args)
+		if err != nil {
+			exitWithResult(newResult(), fmt.Sprintf("Failed to get arguments: %v", err), c.ExitOtherErrors)
+		}
+	}
+
+	if version == "" {
+		exitWithResult(newResult(version), "Error: version string is empty", c.ExitOtherErrors)
+	}
+
+	return version
+}
+
+// writeVersion writes a version to the file given by '--file'.
+func writeVersion(version string) {
+	if err := gosemver.ParseVersionFile(inputFile).Write(version); err != nil {
+		exitWithResult(newResult(version), fmt.Sprintf("Failed to write version: %v", err), c.ExitOtherErrors)
+	}
+}
+
+// readLines returns the non-empty lines of a file, or of the input of cmd if path is '-', skipping
+// '#' comments.
+func readLines(cmd *cobra.Command, path string) ([]string, error) {
+	var (
+		content []byte
+		err     error
+	)
+
+	if path == "-" {
+		content, err = io.ReadAll(cmd.InOrStdin())
+	} else {
+		content, err = os.ReadFile(path) //nolint:gosec
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var lines []string
+
+	for _, line := range strings.Split(string(content), "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines, nil
+}
@@ -0,0 +1,35 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestReadLines(t *testing.T) {
+	content := "1.2.3\n\n# comment\n  v2.0.0-rc.1  \n"
+	want := []string{"1.2.3", "v2.0.0-rc.1"}
+
+	path := filepath.Join(t.TempDir(), "versions.txt")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := &cobra.Command{}
+	cmd.SetIn(strings.NewReader(content))
+
+	for _, source := range []string{"-", path} {
+		got, err := readLines(cmd, source)
+		if err != nil {
+			t.Fatalf("readLines(%q) error = %v", source, err)
+		}
+
+		if !slices.Equal(got, want) {
+			t.Errorf("readLines(%q) = %q, want %q", source, got, want)
+		}
+	}
+}
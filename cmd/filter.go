@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	c "github.com/andreygrechin/gosemver/internal/config"
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+	"github.com/spf13/cobra"
+)
+
+var invertFilter bool
+
+var filterCmd = &cobra.Command{
+	Use:   "filter <constraint> [version...]",
+	Short: "Print the versions satisfying a constraint",
+	Long: `Print the versions satisfying <constraint>, or with '--invert' those not satisfying it, one per
+line in input order. Versions are read from the arguments or, without them, from stdin one per line.
+Invalid versions are skipped with a warning. Exits with status 1 if no version is printed.
+
+Constraints follow the npm syntax: comparators with the operators =, >, >=, <, <= separated by spaces
+or commas must all match, ranges separated by '||' are alternatives, partial versions and wildcards like
+'1.2' or '1.x' match the whole range and 'A - B' is an inclusive range. '^1.2.3' allows changes not
+modifying the left-most non-zero part ('>=1.2.3 <2.0.0', '^0.2.3' is '>=0.2.3 <0.3.0'), '~1.2.3' allows
+patch changes ('>=1.2.3 <1.3.0') and '~>' is the same as '~'. Versions are compared by precedence,
+build metadata is ignored. A prerelease only matches a range with a comparator on a prerelease of the
+same version, '>=1.2.3-rc.1' matches '1.2.3-rc.2' but not '1.2.4-rc.1'. Other syntaxes are selected
+with '--syntax', see 'gosemver range --help'.
+
+Examples:
+  git tag | gosemver filter '>=1.2.0 <2.0.0'
+  gosemver filter '1.x || 2.0.x' 1.4.0 2.0.3 2.1.0
+  gosemver filter '^0.2.3' 0.2.5 0.3.0
+  git tag | gosemver filter --invert '>=1.0.0'
+  gosemver filter --syntax gem '~> 2.2' 2.2.0 2.9.1 3.0.0
+`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		versions := args[1:]
+		if len(versions) == 0 {
+			var err error
+			if versions, err = readLines(cmd, "-"); err != nil {
+				exitWithResult(Result{Input: []string{}}, fmt.Sprintf("Failed to read versions: %v", err),
+					c.ExitOtherErrors)
+			}
+		}
+		res := Result{Input: versions}
+
+		constraint, err := gosemver.ParseConstraintSyntax(args[0], constraintSyntax)
+		if err != nil {
+			exitWithResult(res, fmt.Sprintf("Error: %v", err), c.ExitOtherErrors)
+		}
+
+		matched := []string{}
+		for _, version := range versions {
+			ver, err := gosemver.ParseSemVer(version)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: skipping %v\n", err)
+
+				continue
+			}
+			if constraint.Check(ver) != invertFilter {
+				matched = append(matched, version)
+			}
+		}
+
+		res.Result = matched
+		if len(matched) == 0 {
+			exitWithResult(res, fmt.Sprintf("Error: %v", errNoCandidates), c.ExitInvalidSemver)
+		}
+		printResult(res, strings.Join(matched, "\n"))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(filterCmd)
+	filterCmd.Flags().BoolVar(&invertFilter, "invert", false, `Print the versions not satisfying the constraint`)
+	filterCmd.Flags().StringVar(&constraintSyntax, "syntax", gosemver.SyntaxNative,
+		`Syntax of the constraint: native, npm, cargo, maven, gem, pip or go`)
+}
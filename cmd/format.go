@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+	"github.com/spf13/cobra"
+)
+
+var formatCmd = &cobra.Command{
+	Use:   "format <template> <version|->",
+	Short: "Render a version through a Go template",
+	Long: `Render <version> through a Go template. The template is executed against the parsed version with
+the fields .Major, .Minor, .Patch, .Prerelease, .Build and .Release and the method .String.
+
+Helper functions:
+  pad N VALUE          left-pad VALUE with zeroes to N characters
+  replace OLD NEW S    replace all OLD in S with NEW
+  join SEP VALUES...   join VALUES with SEP
+  truncate N S         keep the first N characters of S
+  sanitizeDockerTag S  make S a valid OCI image tag
+
+The version can be provided either as an argument, via stdin when using '-' as the argument, or read
+from a project file with '--file'. Only one input method can be used at a time.
+
+Examples:
+  gosemver format '{{.Major}}.{{.Minor}}.x' 1.2.3
+  gosemver format '{{join "." .Major .Minor .Patch 0}}' 1.2.3
+  gosemver format '{{.String | sanitizeDockerTag}}' 1.2.3+build.5
+  gosemver format 'v{{.Major}}.{{pad 2 .Minor}}' --file package.json
+`,
+	Args: argsWithFile(2), //nolint:mnd
+	Run: func(cmd *cobra.Command, args []string) {
+		version := inputVersion(cmd, args)
+		res := newResult(version)
+		if res.Version == nil {
+			_, err := gosemver.ParseSemVer(version)
+			exitWithError(res, err)
+		}
+		formatted, err := gosemver.FormatSemVer(args[0], res.Version)
+		if err != nil {
+			exitWithError(res, err)
+		}
+		res.Result = formatted
+		printResult(res, formatted)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(formatCmd)
+	addFileFlag(formatCmd, false)
+}
@@ -0,0 +1,204 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	c "github.com/andreygrechin/gosemver/internal/config"
+	"github.com/andreygrechin/gosemver/internal/git"
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+	"github.com/spf13/cobra"
+)
+
+var (
+	tagPrefix   string
+	component   string
+	branchAware bool
+	branchName  string
+	branchRules []string
+)
+
+var gitCmd = &cobra.Command{
+	Use:   "git",
+	Short: "Derive versions from the git repository in the current directory",
+	Long: `Derive versions from semantic version tags of the git repository in the current directory.
+Only tags reachable from HEAD and starting with the tag prefix are considered.
+
+In a monorepo '--component <path>' selects tags like '<path>/v1.4.0' and restricts the commit
+analysis to changes under <path>. It applies to the latest, describe, next, tag, pseudo and verify
+subcommands; gosemver does not generate changelogs, for a component or otherwise.
+`,
+}
+
+var gitLatestCmd = &cobra.Command{
+	Use:   "latest",
+	Short: "Print the latest released version",
+	Long: `Print the highest semantic version among tags reachable from HEAD.
+
+Examples:
+  gosemver git latest
+  gosemver git latest --tag-prefix release-
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		_, latest, _ := latestTag(git.Repo{})
+		fmt.Println(latest)
+	},
+}
+
+var gitDescribeCmd = &cobra.Command{
+	Use:   "describe",
+	Short: "Print the version of HEAD",
+	Long: `Print the version of HEAD derived from the latest released version and the number of commits
+since it. Without new commits the latest version is printed as is, otherwise the commit counter and
+the abbreviated commit hash are added as build metadata.
+
+With '--branch-aware' the current branch is mapped to a prerelease label and the patch is bumped:
+  main, master          stable, the commit counter is added as build metadata
+  develop               alpha
+  release/*, hotfix/*   rc
+  feature/*, bugfix/*   the rest of the branch name
+  anything else         the branch name
+Branch names are sanitized into valid prerelease identifiers and combined with the commit counter.
+If the latest version is a prerelease, the patch is kept and its prerelease is prefixed instead, so
+that 1.2.0-rc.1 becomes 1.2.0-rc.1.foo-bar.5 and sorts above the tag.
+Rules given with '--branch-rule <regexp>=<label>' are checked before the default ones, the label may
+refer to capture groups and an empty label marks a stable branch.
+
+Examples:
+  gosemver git describe
+  gosemver git describe --branch-aware
+  gosemver git describe --branch-aware --branch-rule '^next$=beta' --branch-rule '^support/.+$='
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Println(describeVersion(git.Repo{}))
+	},
+}
+
+// describeVersion returns the version of HEAD derived from the latest released version.
+func describeVersion(repo git.Repo) *gosemver.SemVer {
+	_, latest, commits := latestTag(repo)
+
+	if branchAware {
+		label, stable := currentBranchLabel(repo)
+
+		return gosemver.BranchVersion(latest, label, stable, commits)
+	}
+
+	if commits > 0 {
+		head, err := repo.ShortHead()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(c.ExitOtherErrors)
+		}
+		latest.Build = fmt.Sprintf("%d.g%s", commits, head)
+	}
+
+	return latest
+}
+
+// latestTag finds the latest version tag and the number of commits since it.
+// Without version tags 0.0.0 and the number of all commits are returned.
+func latestTag(repo git.Repo) (string, *gosemver.SemVer, int) {
+	tags, err := repo.MergedTags()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(c.ExitOtherErrors)
+	}
+
+	tag, latest, err := gosemver.LatestTag(tags, currentTagPrefix())
+	if errors.Is(err, gosemver.ErrNoVersionTags) {
+		tag, latest = "", &gosemver.SemVer{Release: "0.0.0"}
+	}
+
+	commits, err := repo.CommitsSince(tag, componentPaths()...)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(c.ExitOtherErrors)
+	}
+
+	return tag, latest, commits
+}
+
+// componentPaths returns the paths commits of the selected component touch.
+func componentPaths() []string {
+	if component == "" {
+		return nil
+	}
+
+	return []string{component}
+}
+
+// currentTagPrefix returns the tag prefix of the selected component.
+func currentTagPrefix() string {
+	return gosemver.ComponentTagPrefix(component, tagPrefix)
+}
+
+func currentBranchLabel(repo git.Repo) (string, bool) {
+	branch := branchName
+	if branch == "" {
+		var err error
+
+		branch, err = repo.CurrentBranch()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(c.ExitOtherErrors)
+		}
+	}
+
+	rules := make([]gosemver.BranchRule, 0, len(branchRules)+len(gosemver.DefaultBranchRules))
+	for _, r := range branchRules {
+		rule, err := gosemver.ParseBranchRule(r)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(c.ExitOtherErrors)
+		}
+		rules = append(rules, rule)
+	}
+	rules = append(rules, gosemver.DefaultBranchRules...)
+
+	label, stable, err := gosemver.BranchPrerelease(branch, rules)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(c.ExitOtherErrors)
+	}
+
+	return label, stable
+}
+
+func init() {
+	rootCmd.AddCommand(gitCmd)
+	gitCmd.AddCommand(gitLatestCmd, gitDescribeCmd)
+	gitCmd.PersistentFlags().StringVar(
+		&tagPrefix,
+		"tag-prefix",
+		"v",
+		`Prefix of version tags`,
+	)
+	gitCmd.PersistentFlags().StringVar(
+		&component,
+		"component",
+		"",
+		`Path of a monorepo component versioned by '<path>/<prefix><version>' tags`,
+	)
+	gitDescribeCmd.Flags().BoolVar(
+		&branchAware,
+		"branch-aware",
+		false,
+		`Map the current branch to a prerelease label`,
+	)
+	gitDescribeCmd.Flags().StringVar(
+		&branchName,
+		"branch",
+		"",
+		`Use this branch name instead of the checked out one, e.g. in a detached HEAD`,
+	)
+	gitDescribeCmd.Flags().StringArrayVar(
+		&branchRules,
+		"branch-rule",
+		nil,
+		`Map branches matching a regexp to a prerelease label, as '<regexp>=<label>'`,
+	)
+}
@@ -0,0 +1,242 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+
+	c "github.com/andreygrechin/gosemver/internal/config"
+	"github.com/andreygrechin/gosemver/internal/git"
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+	"github.com/spf13/cobra"
+)
+
+// autoID is the identifier deriving the bump from Conventional Commits.
+const autoID = "auto"
+
+var errAlreadyReleased = errors.New("nothing to release")
+
+var (
+	gitPrereleaseID string
+	tagMessage      string
+)
+
+var gitNextCmd = &cobra.Command{
+	Use:   "next <semver_id>",
+	Short: "Print the next version after the latest released one",
+	Long: `Increment a semantic version identifier <semver_id> of the latest released version, where identifier
+is (major|minor|patch|prerelease|release|auto), and print the result. If the latest version is
+released, 'prerelease' starts the prereleases of the next patch version, with the first of the
+prerelease stages of the project configuration unless '--prerelease' is given, and 'release' is
+rejected.
+
+With 'auto'' the identifier follows the Conventional Commits since the latest released version: a '!'
+after the type or a 'BREAKING CHANGE' footer bumps the major version, other types bump what the
+commit_types setting of the project configuration maps them to (by default feat to minor, fix and
+perf to patch).
+
+A major bump fails inside a Go module whose path would need a new '/vN' suffix, see 'gosemver gomod
+check'. Use '--allow-major' to bump anyway, e.g. before renaming the module.
+
+Examples:
+  gosemver git next minor
+  gosemver git next prerelease --prerelease rc.1
+  gosemver git next auto
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		_, next := nextVersion(git.Repo{}, args[0])
+		fmt.Println(next)
+	},
+}
+
+var gitTagCmd = &cobra.Command{
+	Use:   "tag <semver_id>",
+	Short: "Create an annotated tag for the next version",
+	Long: `Increment a semantic version identifier <semver_id> of the latest released version, where identifier
+is (major|minor|patch|prerelease|release|auto), and create an annotated tag for the result at HEAD. If
+the latest version is released, 'prerelease' starts the prereleases of the next patch version, as
+with 'gosemver git next', and 'release' is rejected. With 'auto' the identifier follows the Conventional Commits since the latest
+released version, see 'gosemver git next'.
+
+The command refuses to tag if the working tree is dirty or HEAD already has a version tag.
+The tag message is a Go template with the following fields:
+  .Tag       the new tag
+  .Version   the new version
+  .Previous  the latest released version
+
+Examples:
+  gosemver git tag patch
+  gosemver git tag auto
+  gosemver git tag minor --message 'Release {{.Version}} (previous {{.Previous}})'
+  gosemver git tag patch --component services/api
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		repo := git.Repo{}
+
+		tmpl, err := template.New("message").Parse(tagMessage)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid tag message template: %v\n", err)
+			os.Exit(c.ExitOtherErrors)
+		}
+
+		dirty, err := repo.IsDirty()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(c.ExitOtherErrors)
+		}
+		if dirty {
+			fmt.Fprintln(os.Stderr, "Error: the working tree has uncommitted changes")
+			os.Exit(c.ExitOtherErrors)
+		}
+
+		headTags, err := repo.TagsAtHead()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(c.ExitOtherErrors)
+		}
+		if headTag, _, err := gosemver.LatestTag(headTags, currentTagPrefix()); err == nil {
+			fmt.Fprintf(os.Stderr, "Error: HEAD is already tagged as %s\n", headTag)
+			os.Exit(c.ExitOtherErrors)
+		}
+
+		previous, next := nextVersion(repo, args[0])
+		tag := currentTagPrefix() + next.String()
+
+		var message strings.Builder
+		if err := tmpl.Execute(&message, map[string]string{
+			"Tag":      tag,
+			"Version":  next.String(),
+			"Previous": previous.String(),
+		}); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: failed to render tag message: %v\n", err)
+			os.Exit(c.ExitOtherErrors)
+		}
+
+		if err := repo.CreateAnnotatedTag(tag, message.String()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(c.ExitOtherErrors)
+		}
+		fmt.Println(tag)
+	},
+}
+
+// nextVersion bumps the latest released version and returns both.
+func nextVersion(repo git.Repo, semverID string) (*gosemver.SemVer, *gosemver.SemVer) {
+	if semverID != gosemver.Prerelease && gitPrereleaseID != "" {
+		fmt.Fprintf(os.Stderr, "Error: The '--prerelease' flag can only be used with the 'prerelease' identifier\n")
+		os.Exit(c.ExitOtherErrors)
+	}
+	if semverID == gosemver.Build {
+		fmt.Fprintln(os.Stderr, "Error: build metadata cannot be bumped for a new release")
+		os.Exit(c.ExitOtherErrors)
+	}
+
+	tag, latest, _ := latestTag(repo)
+
+	if semverID == autoID {
+		semverID = commitBump(repo, tag)
+	}
+
+	next, err := bumpTagged(latest, tag != "", semverID, gitPrereleaseID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		if errors.Is(err, gosemver.ErrInvalidVersion) {
+			os.Exit(c.ExitInvalidSemver)
+		}
+		os.Exit(c.ExitOtherErrors)
+	}
+
+	if semverID == gosemver.Major && !allowMajor {
+		if err := checkBumpedModule("", latest, next); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v, use '--allow-major' to bump anyway\n", err)
+			os.Exit(c.ExitInvalidSemver)
+		}
+	}
+
+	return latest, next
+}
+
+// commitBump returns the identifier the commits since tag bump, mapping their Conventional Commits
+// types by the commit_types setting of the project configuration.
+func commitBump(repo git.Repo, tag string) string {
+	messages, err := repo.CommitMessages(tag, componentPaths()...)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(c.ExitOtherErrors)
+	}
+
+	semverID := gosemver.CommitBump(messages, projectConfig.CommitTypes)
+	if semverID == "" {
+		fmt.Fprintf(os.Stderr, "Error: no commits since %s bump the version\n", tagOrStart(tag))
+		os.Exit(c.ExitOtherErrors)
+	}
+
+	return semverID
+}
+
+// tagOrStart describes the starting point of commits counted since tag.
+func tagOrStart(tag string) string {
+	if tag == "" {
+		return "the first commit"
+	}
+
+	return tag
+}
+
+// bumpTagged bumps the latest version, tagged reports whether a tag holds it. A prerelease of a
+// released version starts the prereleases of the next patch, as the prerelease of the same version
+// sorts below the release, and releasing a released version is an error. The prereleases of a
+// release start with the ID of the project configuration unless prereleaseID is given.
+func bumpTagged(latest *gosemver.SemVer, tagged bool, semverID, prereleaseID string) (*gosemver.SemVer, error) {
+	version := latest.String()
+
+	if semverID == gosemver.Prerelease && prereleaseID == "" && latest.Prerelease == "" {
+		prereleaseID = defaultPrereleaseID()
+	}
+
+	if tagged && latest.Prerelease == "" {
+		switch semverID {
+		case gosemver.Release:
+			return nil, fmt.Errorf("%w: %s is already released", errAlreadyReleased, latest)
+		case gosemver.Prerelease:
+			patch, err := gosemver.BumpSemVer(gosemver.Patch, version, "", "")
+			if err != nil {
+				return nil, err
+			}
+
+			version = patch.String()
+		}
+	}
+
+	return gosemver.BumpSemVer(semverID, version, prereleaseID, "")
+}
+
+func init() {
+	gitCmd.AddCommand(gitNextCmd, gitTagCmd)
+	for _, cmd := range []*cobra.Command{gitNextCmd, gitTagCmd} {
+		cmd.Flags().StringVarP(
+			&gitPrereleaseID,
+			"prerelease",
+			"p",
+			"",
+			`Add or replace a new prerelease ID, valid only with the 'prerelease' SemVer identifier`,
+		)
+		cmd.Flags().BoolVar(
+			&allowMajor,
+			"allow-major",
+			false,
+			`Bump the major version even if the Go module path does not allow it`,
+		)
+	}
+	gitTagCmd.Flags().StringVarP(
+		&tagMessage,
+		"message",
+		"m",
+		"Release {{.Tag}}",
+		`Tag message template`,
+	)
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/andreygrechin/gosemver/internal/config"
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+func TestBumpTagged(t *testing.T) {
+	tests := []struct {
+		name         string
+		latest       string
+		tagged       bool
+		semverID     string
+		prereleaseID string
+		want         string
+		wantErr      error
+	}{
+		{"patch", "1.2.3", true, gosemver.Patch, "", "1.2.4", nil},
+		{"minor of prerelease", "1.2.3-rc.1", true, gosemver.Minor, "", "1.3.0", nil},
+		{"prerelease of release", "1.2.3", true, gosemver.Prerelease, "", "1.2.4-1", nil},
+		{"prerelease with ID", "1.2.3", true, gosemver.Prerelease, "rc.1", "1.2.4-rc.1", nil},
+		{"prerelease of prerelease", "1.2.4-rc.1", true, gosemver.Prerelease, "", "1.2.4-rc.2", nil},
+		{"prerelease without tags", "0.0.0", false, gosemver.Prerelease, "", "0.0.0-1", nil},
+		{"release of prerelease", "1.2.4-rc.2", true, gosemver.Release, "", "1.2.4", nil},
+		{"release of release", "1.2.3", true, gosemver.Release, "", "", errAlreadyReleased},
+		{"release without tags", "0.0.0", false, gosemver.Release, "", "0.0.0", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			latest, err := gosemver.ParseSemVer(tt.latest)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := bumpTagged(latest, tt.tagged, tt.semverID, tt.prereleaseID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("bumpTagged() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr == nil && got.String() != tt.want {
+				t.Errorf("bumpTagged() = %s, want %s", got, tt.want)
+			}
+
+			if tt.wantErr == nil && tt.tagged {
+				if cmp, _ := gosemver.CompareSemVer(got.String(), tt.latest); cmp <= 0 {
+					t.Errorf("bumpTagged() = %s does not sort above %s", got, tt.latest)
+				}
+			}
+		})
+	}
+}
+
+func TestBumpTaggedPrereleaseStages(t *testing.T) {
+	tests := []struct {
+		name      string
+		latest    string
+		numbering string
+		want      string
+	}{
+		{"dot numbering", "1.2.3", "dot", "1.2.4-alpha.1"},
+		{"no numbering", "1.2.3", "none", "1.2.4-alpha1"},
+		{"prerelease keeps its stage", "1.2.4-rc.1", "dot", "1.2.4-rc.2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saved := projectConfig
+			t.Cleanup(func() { projectConfig = saved })
+
+			projectConfig = config.DefaultProject()
+			projectConfig.Prerelease.Stages = []string{"alpha", "beta", "rc"}
+			projectConfig.Prerelease.Numbering = tt.numbering
+
+			latest, err := gosemver.ParseSemVer(tt.latest)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := bumpTagged(latest, true, gosemver.Prerelease, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got.String() != tt.want {
+				t.Errorf("bumpTagged() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
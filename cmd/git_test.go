@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/andreygrechin/gosemver/internal/git"
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+// newComponentRepo creates a repository with the components api and web in a temporary directory,
+// tagged api/v1.2.0 and web/v2.0.0, and one commit touching api after the tags.
+func newComponentRepo(t *testing.T) git.Repo {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	repo := git.Repo{Dir: t.TempDir()}
+	run := func(args ...string) {
+		t.Helper()
+
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repo.Dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+	commit := func(path string) {
+		t.Helper()
+
+		file := filepath.Join(repo.Dir, path)
+		if err := os.MkdirAll(filepath.Dir(file), 0o750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(file, []byte(path), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		run("add", path)
+		run("commit", "--quiet", "--no-gpg-sign", "--message", "change "+path)
+	}
+
+	run("init", "--quiet", "--initial-branch", "main")
+	run("config", "user.name", "Test")
+	run("config", "user.email", "test@example.com")
+	run("config", "tag.gpgSign", "false")
+
+	commit("api/main.go")
+	if err := repo.CreateAnnotatedTag("api/v1.2.0", "Release api/v1.2.0"); err != nil {
+		t.Fatal(err)
+	}
+	commit("web/index.html")
+	if err := repo.CreateAnnotatedTag("web/v2.0.0", "Release web/v2.0.0"); err != nil {
+		t.Fatal(err)
+	}
+	commit("api/handler.go")
+
+	return repo
+}
+
+// selectComponent selects a component and the default tag prefix for the duration of a test.
+func selectComponent(t *testing.T, path string) {
+	t.Helper()
+
+	savedComponent, savedPrefix := component, tagPrefix
+	t.Cleanup(func() { component, tagPrefix = savedComponent, savedPrefix })
+
+	component, tagPrefix = path, "v"
+}
+
+func TestDescribeComponent(t *testing.T) {
+	repo := newComponentRepo(t)
+
+	head, err := repo.ShortHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		component string
+		want      string
+	}{
+		{"api", "1.2.0+1.g" + head},
+		{"web", "2.0.0"},
+		{"cli", "0.0.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.component, func(t *testing.T) {
+			selectComponent(t, tt.component)
+
+			if got := describeVersion(repo).String(); got != tt.want {
+				t.Errorf("describeVersion() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextVersionComponent(t *testing.T) {
+	repo := newComponentRepo(t)
+
+	tests := []struct {
+		component  string
+		semverID   string
+		wantLatest string
+		want       string
+	}{
+		{"api", gosemver.Patch, "1.2.0", "1.2.1"},
+		{"web", gosemver.Minor, "2.0.0", "2.1.0"},
+		{"api", gosemver.Prerelease, "1.2.0", "1.2.1-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.component+" "+tt.semverID, func(t *testing.T) {
+			selectComponent(t, tt.component)
+
+			latest, next := nextVersion(repo, tt.semverID)
+			if latest.String() != tt.wantLatest || next.String() != tt.want {
+				t.Errorf("nextVersion() = %s, %s, want %s, %s", latest, next, tt.wantLatest, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path"
+
+	c "github.com/andreygrechin/gosemver/internal/config"
+	"github.com/andreygrechin/gosemver/internal/git"
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+	"github.com/spf13/cobra"
+)
+
+var versionFile string
+
+var gitVerifyCmd = &cobra.Command{
+	Use:   "verify <tag>",
+	Short: "Verify that a tag matches the version file",
+	Long: `Verify that <tag> denotes exactly the version declared in the version file of the tagged commit.
+Exits with status 0 if they match, 1 if they differ or either is not a valid semantic version.
+With '--component' the version file is looked up in the component directory.
+
+Examples:
+  gosemver git verify v1.2.3
+  gosemver git verify v1.2.3 --version-file internal/VERSION
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		tag := args[0]
+
+		declared, err := git.Repo{}.ShowFile(tag, path.Join(component, versionFile))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(c.ExitOtherErrors)
+		}
+
+		if err := gosemver.VerifyTag(tag, currentTagPrefix(), declared); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(c.ExitInvalidSemver)
+		}
+		fmt.Println("valid")
+	},
+}
+
+func init() {
+	gitCmd.AddCommand(gitVerifyCmd)
+	gitVerifyCmd.Flags().StringVar(
+		&versionFile,
+		"version-file",
+		"VERSION",
+		`Path of the version file relative to the repository root or the component`,
+	)
+}
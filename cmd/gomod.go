@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	c "github.com/andreygrechin/gosemver/internal/config"
+	"github.com/andreygrechin/gosemver/internal/git"
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+	"github.com/spf13/cobra"
+)
+
+var (
+	modFile    string
+	fixHint    bool
+	allowMajor bool
+
+	errNoModule = errors.New("no go.mod found")
+)
+
+var gomodCmd = &cobra.Command{
+	Use:   "gomod",
+	Short: "Check Go module paths against versions",
+}
+
+var gomodCheckCmd = &cobra.Command{
+	Use:   "check [version|-]",
+	Short: "Check that the module path matches the major version",
+	Long: `Check that the module path declared in go.mod matches the major version of <version>. Versions
+v2.0.0 and higher require a '/vN' module path suffix, v0 and v1 versions require none.
+Exits with status 0 if they match, 1 if not.
+
+Without a version the latest version tag reachable from HEAD is checked.
+
+The version can be provided either as an argument or via stdin when using '-' as the argument.
+Only one input method can be used at a time.
+
+Examples:
+  gosemver gomod check
+  gosemver gomod check v2.0.0 --fix-hint
+  gosemver git next major | gosemver gomod check -
+`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var version string
+		if len(args) == 0 {
+			_, latest, _ := latestTag(git.Repo{})
+			version = latest.String()
+		} else {
+			var err error
+
+			version, err = gosemver.GetLastArg(*cmd, args)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Failed to get arguments: %v\n", err)
+				os.Exit(c.ExitOtherErrors)
+			}
+		}
+		if version == "" {
+			fmt.Fprintln(os.Stderr, "Error: version string is empty")
+			os.Exit(c.ExitOtherErrors)
+		}
+
+		ver, err := gosemver.ParseSemVer(version)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(c.ExitInvalidSemver)
+		}
+
+		path := modFile
+		if path == "" {
+			path, err = findModFile()
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(c.ExitOtherErrors)
+		}
+
+		modulePath, err := readModulePath(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(c.ExitOtherErrors)
+		}
+
+		if err := gosemver.CheckModuleMajor(modulePath, ver); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			if fixHint {
+				fmt.Fprintf(os.Stderr, "Hint: run 'go mod edit -module %s' and update imports of %s\n",
+					gosemver.ModulePathForMajor(modulePath, ver.Major), modulePath)
+			}
+			os.Exit(c.ExitInvalidSemver)
+		}
+		fmt.Println("valid")
+	},
+}
+
+// readModulePath reads the module path from a go.mod file.
+func readModulePath(path string) (string, error) {
+	content, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	return gosemver.ModulePath(string(content))
+}
+
+// findModFile returns the go.mod file of the module containing the current directory.
+func findModFile() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		path := filepath.Join(dir, "go.mod")
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", errNoModule
+		}
+
+		dir = parent
+	}
+}
+
+// checkBumpedModule returns an error if a bumped version cannot be released by the Go module
+// declared in the go.mod file at path, or by the module containing the current directory if path is
+// empty. Versions the module path does not match before the bump are not checked.
+func checkBumpedModule(path string, original, bumped *gosemver.SemVer) error {
+	if path == "" {
+		var err error
+
+		path, err = findModFile()
+		if errors.Is(err, errNoModule) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	modulePath, err := readModulePath(path)
+	if err != nil {
+		return err
+	}
+
+	if gosemver.CheckModuleMajor(modulePath, original) != nil {
+		return nil
+	}
+
+	return gosemver.CheckModuleMajor(modulePath, bumped)
+}
+
+// isModFile reports whether a '--file' specification reads the version from a go.mod file.
+func isModFile(spec string) bool {
+	return spec != "" && filepath.Base(gosemver.ParseVersionFile(spec).Path) == "go.mod"
+}
+
+func init() {
+	rootCmd.AddCommand(gomodCmd)
+	gomodCmd.AddCommand(gomodCheckCmd)
+	gomodCheckCmd.Flags().StringVar(
+		&modFile,
+		"modfile",
+		"",
+		`Path of the go.mod file, by default the one of the module containing the current directory`,
+	)
+	gomodCheckCmd.Flags().BoolVar(
+		&fixHint,
+		"fix-hint",
+		false,
+		`Suggest how to fix the module path on mismatch`,
+	)
+	gomodCheckCmd.Flags().StringVar(
+		&tagPrefix,
+		"tag-prefix",
+		"v",
+		`Prefix of version tags, used without a version`,
+	)
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+	"github.com/spf13/cobra"
+)
+
+var nextPrereleaseID string
+
+// nextVersionResult is a successor in the structured output of 'next'.
+type nextVersionResult struct {
+	Kind    string `json:"kind" yaml:"kind"`
+	Version string `json:"version" yaml:"version"`
+}
+
+var nextCmd = &cobra.Command{
+	Use:   "next <version|->",
+	Short: "List the possible next versions",
+	Long: `List the possible successors of <version>: the next patch, minor and major releases, the first
+prereleases of them (prepatch, preminor, premajor) and, for a prerelease, the next prerelease and the
+release. Outputs one tab-separated '<kind> <version>' pair per line.
+
+Prereleases start with the ID given by '--prerelease', the first of the configured prerelease stages
+or '1'.
+
+The version can be provided either as an argument, via stdin when using '-' as the argument, or read
+from a project file with '--file'. Only one input method can be used at a time.
+
+Examples:
+  gosemver next 1.2.3
+  gosemver next 2.0.0-rc.1 --output json
+  gosemver next 1.2.3 --prerelease beta.1
+`,
+	Args: argsWithFile(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		version := inputVersion(cmd, args)
+		res := newResult(version)
+
+		prereleaseID := nextPrereleaseID
+		if prereleaseID == "" {
+			prereleaseID = defaultPrereleaseID()
+		}
+
+		successors, err := gosemver.NextVersions(version, prereleaseID)
+		if err != nil {
+			exitWithError(res, err)
+		}
+
+		results := make([]nextVersionResult, 0, len(successors))
+		lines := make([]string, 0, len(successors))
+		for _, successor := range successors {
+			results = append(results, nextVersionResult{Kind: successor.Kind, Version: successor.Version.String()})
+			lines = append(lines, fmt.Sprintf("%s\t%s", successor.Kind, successor.Version))
+		}
+		res.Result = results
+		printResult(res, strings.Join(lines, "\n"))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(nextCmd)
+	addFileFlag(nextCmd, false)
+	nextCmd.Flags().StringVarP(
+		&nextPrereleaseID,
+		"prerelease",
+		"p",
+		"",
+		`Prerelease ID of the prepatch, preminor and premajor versions`,
+	)
+}
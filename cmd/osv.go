@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	c "github.com/andreygrechin/gosemver/internal/config"
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+	"github.com/spf13/cobra"
+)
+
+var osvPackage string
+
+var osvCmd = &cobra.Command{
+	Use:   "osv",
+	Short: "Work with OSV vulnerability advisories",
+	Long:  `Work with vulnerability advisories in the OSV format, see https://ossf.github.io/osv-schema/.`,
+}
+
+var osvCheckCmd = &cobra.Command{
+	Use:   "check <version> <advisory.json>...",
+	Short: "Check whether OSV advisories affect a version",
+	Long: `Check whether the OSV advisories in the given JSON files affect <version>. Exits with status 0 if
+none does, printing "not affected", or 1 if any does, printing a line per affected package with the
+advisory ID, the package and the version fixing it.
+
+A package is affected if the version is listed in its 'versions' or lies in one of its SEMVER ranges:
+walking the events sorted by version, an 'introduced' event at or below the version marks it affected,
+and a 'fixed' event at or below it or a 'last_affected' event below it marks it unaffected. Ranges of
+other types are ignored. With '--package' only packages with that name are considered.
+
+Examples:
+  gosemver osv check 1.4.0 GHSA-xxxx-xxxx-xxxx.json
+  gosemver osv check --package golang.org/x/net 0.17.0 advisories/*.json
+  gosemver osv check --output json 1.4.0 advisories/*.json
+`,
+	Args: cobra.MinimumNArgs(2), //nolint:mnd
+	Run: func(cmd *cobra.Command, args []string) {
+		res := newResult(args...)
+		if res.Version == nil {
+			_, err := gosemver.ParseSemVer(args[0])
+			exitWithError(res, err)
+		}
+
+		matches := []gosemver.OSVMatch{}
+		for _, path := range args[1:] {
+			advisory, err := gosemver.ReadOSVAdvisory(path)
+			if err != nil {
+				exitWithResult(res, fmt.Sprintf("Error: %v", err), c.ExitOtherErrors)
+			}
+
+			affected, err := advisory.Check(args[0], osvPackage)
+			if err != nil {
+				exitWithResult(res, fmt.Sprintf("Error: %s: %v", path, err), c.ExitOtherErrors)
+			}
+			matches = append(matches, affected...)
+		}
+
+		res.Result = matches
+		if len(matches) == 0 {
+			printResult(res, "not affected")
+
+			return
+		}
+
+		lines := make([]string, 0, len(matches))
+		for _, match := range matches {
+			fixed := "no fix"
+			if match.Fixed != "" {
+				fixed = "fixed in " + match.Fixed
+			}
+			lines = append(lines, fmt.Sprintf("%s\t%s\t%s", match.ID, match.Package.Name, fixed))
+		}
+		printResult(res, strings.Join(lines, "\n"))
+		os.Exit(c.ExitInvalidSemver)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(osvCmd)
+	osvCmd.AddCommand(osvCheckCmd)
+	osvCheckCmd.Flags().StringVar(&osvPackage, "package", "", `Only check packages with this name`)
+}
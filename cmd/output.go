@@ -0,0 +1,214 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	c "github.com/andreygrechin/gosemver/internal/config"
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	outputText     = "text"
+	outputJSON     = "json"
+	outputYAML     = "yaml"
+	outputTemplate = "template"
+)
+
+var (
+	outputFormat string
+	templateText string
+
+	errInvalidOutput = errors.New("invalid output format")
+)
+
+// Result is the structured output of validate, compare, diff, bump and get.
+type Result struct {
+	// Line is the number of the input line in '--batch' mode.
+	Line int `json:"line,omitempty" yaml:"line,omitempty"`
+	// Input holds the versions the command was given.
+	Input []string `json:"input" yaml:"input"`
+	// Version is the parsed first input version.
+	Version *gosemver.SemVer `json:"version,omitempty" yaml:"version,omitempty"`
+	// Result is the outcome of the command: a boolean for validate, an integer for compare, a string
+	// for diff, bump and get, or the version object for 'get json'.
+	Result any `json:"result,omitempty" yaml:"result,omitempty"`
+	// Proof lists the reasons for the result of the 'range' commands: the conflicting bounds of an
+	// empty range or a version telling two ranges apart.
+	Proof []string `json:"proof,omitempty" yaml:"proof,omitempty"`
+	// Error describes why the command failed.
+	Error string `json:"error,omitempty" yaml:"error,omitempty"`
+
+	// data is the version the template is executed against.
+	data *gosemver.SemVer
+}
+
+// newResult returns a result for the input versions with the first one parsed, if possible.
+func newResult(input ...string) Result {
+	res := Result{Input: append([]string{}, input...)}
+	if len(input) > 0 {
+		res.Version, _ = gosemver.ParseSemVer(input[0])
+		res.data = res.Version
+	}
+
+	return res
+}
+
+// checkOutputFlags validates the '--output' and '--template' flags.
+func checkOutputFlags() error {
+	switch outputFormat {
+	case outputText, outputJSON, outputYAML:
+	case outputTemplate:
+		if templateText == "" {
+			return fmt.Errorf("%w: the 'template' output requires the '--template' flag", errInvalidOutput)
+		}
+	default:
+		return fmt.Errorf("%w: %s, expected text, json, yaml or template", errInvalidOutput, outputFormat)
+	}
+
+	if templateText != "" && outputFormat != outputTemplate {
+		outputFormat = outputTemplate
+	}
+
+	return nil
+}
+
+// structuredCommands returns the commands printing a Result, the others print text only.
+func structuredCommands() []*cobra.Command {
+	return []*cobra.Command{
+		validateCmd, compareCmd, diffCmd, bumpCmd, getCmd, convertCmd, formatCmd, dockerTagsCmd,
+		maxCmd, minCmd, latestCmd, filterCmd, nextCmd, satisfiesCmd, rangeConvertCmd, rangeIntersectCmd,
+		rangeUnionCmd, rangeSubsetCmd, rangeEmptyCmd, rangeSimplifyCmd, osvCheckCmd, sbomCheckCmd,
+	}
+}
+
+// checkOutputSupport rejects '--output' and '--template' on the command line of a command printing
+// text only. An output format set by the project configuration falls back to text for such commands.
+func checkOutputSupport(cmd *cobra.Command) error {
+	if outputFormat == outputText || slices.Contains(structuredCommands(), cmd) {
+		return nil
+	}
+
+	if cmd.Flags().Changed("output") || cmd.Flags().Changed("template") {
+		return fmt.Errorf("%w: '%s' prints text only", errInvalidOutput, cmd.CommandPath())
+	}
+
+	outputFormat = outputText
+
+	return nil
+}
+
+// printResult prints a successful result, text is used for the text output.
+func printResult(res Result, text string) {
+	if err := writeResult(res, text); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(c.ExitOtherErrors)
+	}
+}
+
+// exitWithResult prints a failed result and exits with code. In text and template output
+// the message is printed to stderr.
+func exitWithResult(res Result, message string, code int) {
+	if outputFormat == outputJSON || outputFormat == outputYAML {
+		if res.Error == "" {
+			res.Error = strings.TrimPrefix(message, "Error: ")
+		}
+
+		printResult(res, "")
+	} else {
+		fmt.Fprintln(os.Stderr, message)
+	}
+
+	os.Exit(code)
+}
+
+// exitWithError prints a failed result for err, exiting with ExitInvalidSemver for invalid versions
+// and ExitOtherErrors otherwise.
+func exitWithError(res Result, err error) {
+	exitWithOutcome(failedWithError(res, err))
+}
+
+// outcome is the result of processing one input, shared by the single and '--batch' modes.
+type outcome struct {
+	res Result
+	// text is printed in text output on success.
+	text string
+	// message is printed in text output on failure.
+	message string
+	code    int
+}
+
+func succeeded(res Result, text string) outcome {
+	return outcome{res: res, text: text, code: c.ExitOK}
+}
+
+func failed(res Result, message string, code int) outcome {
+	if res.Error == "" {
+		res.Error = strings.TrimPrefix(message, "Error: ")
+	}
+
+	return outcome{res: res, message: message, code: code}
+}
+
+// failedWithError fails with ExitInvalidSemver for invalid versions and ExitOtherErrors otherwise.
+func failedWithError(res Result, err error) outcome {
+	res.Error = err.Error()
+	if errors.Is(err, gosemver.ErrInvalidVersion) {
+		return failed(res, fmt.Sprintf("Error: %v", err), c.ExitInvalidSemver)
+	}
+
+	return failed(res, fmt.Sprintf("Error: %v", err), c.ExitOtherErrors)
+}
+
+// exitWithOutcome prints an outcome and exits on failure.
+func exitWithOutcome(o outcome) {
+	if o.code != c.ExitOK {
+		exitWithResult(o.res, o.message, o.code)
+	}
+
+	printResult(o.res, o.text)
+}
+
+func writeResult(res Result, text string) error {
+	switch outputFormat {
+	case outputJSON:
+		out, err := json.Marshal(res)
+		if err != nil {
+			return fmt.Errorf("%w: %w", gosemver.ErrJSONMarshal, err)
+		}
+
+		fmt.Println(string(out))
+	case outputYAML:
+		enc := yaml.NewEncoder(os.Stdout)
+		enc.SetIndent(2) //nolint:mnd
+
+		if err := enc.Encode(res); err != nil {
+			return err
+		}
+
+		return enc.Close()
+	case outputTemplate:
+		if res.data == nil {
+			fmt.Println(text)
+
+			return nil
+		}
+
+		out, err := gosemver.FormatSemVer(templateText, res.data)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(strings.TrimSuffix(out, "\n"))
+	default:
+		fmt.Println(text)
+	}
+
+	return nil
+}
@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	c "github.com/andreygrechin/gosemver/internal/config"
+	"github.com/andreygrechin/gosemver/internal/git"
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+	"github.com/spf13/cobra"
+)
+
+const pseudoNewNArgs = 2
+
+var (
+	pseudoBase  string
+	pseudoMajor int
+
+	errInvalidCommitTime = errors.New("invalid commit time")
+)
+
+var pseudoCmd = &cobra.Command{
+	Use:   "pseudo",
+	Short: "Parse and generate Go pseudo-versions",
+	Long: `Parse and generate Go pseudo-versions, which identify untagged commits in Go modules in one of
+three forms:
+  vX.0.0-yyyymmddhhmmss-abcdefabcdef       no earlier version tag
+  vX.Y.Z-pre.0.yyyymmddhhmmss-abcdefabcdef  after the prerelease vX.Y.Z-pre
+  vX.Y.Z-0.yyyymmddhhmmss-abcdefabcdef      after the release vX.Y.(Z-1)
+`,
+}
+
+var pseudoParseCmd = &cobra.Command{
+	Use:   "parse <version|->",
+	Short: "Extract the base version, commit time and revision of a pseudo-version",
+	Long: `Extract the base version, commit time and revision of a Go pseudo-version and print them as JSON
+object. Exits with status 1 if the version is not a pseudo-version.
+
+The version can be provided either as an argument or via stdin when using '-' as the argument.
+Only one input method can be used at a time.
+
+Examples:
+  gosemver pseudo parse v0.0.0-20240101120000-abcdef123456
+  gosemver pseudo parse v1.2.4-0.20240101120000-abcdef123456
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		version, err := gosemver.GetLastArg(*cmd, args)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to get arguments: %v\n", err)
+			os.Exit(c.ExitOtherErrors)
+		}
+		if version == "" {
+			fmt.Fprintln(os.Stderr, "Error: version string is empty")
+			os.Exit(c.ExitOtherErrors)
+		}
+
+		pseudo, err := gosemver.ParsePseudoVersion(version)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(c.ExitInvalidSemver)
+		}
+
+		jsonBytes, err := json.Marshal(pseudo)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v: %v\n", gosemver.ErrJSONMarshal, err)
+			os.Exit(c.ExitOtherErrors)
+		}
+		fmt.Println(string(jsonBytes))
+	},
+}
+
+var pseudoNewCmd = &cobra.Command{
+	Use:   "new <commit_time> <revision>",
+	Short: "Generate a pseudo-version for a commit",
+	Long: `Generate a Go pseudo-version for a commit made at <commit_time> with hash <revision> on top of the
+version given by '--base'. Without a base the vX.0.0 form with the '--major' version is generated.
+The commit time is either RFC 3339, yyyymmddhhmmss in UTC or Unix seconds.
+
+Examples:
+  gosemver pseudo new 2024-01-01T12:00:00Z abcdef1234567890
+  gosemver pseudo new 1704110400 abcdef1234567890 --base v1.2.3
+`,
+	Args: cobra.ExactArgs(pseudoNewNArgs),
+	Run: func(cmd *cobra.Command, args []string) {
+		commitTime, err := parseCommitTime(args[0])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(c.ExitOtherErrors)
+		}
+
+		printPseudoVersion(pseudoMajor, pseudoBase, commitTime, args[1])
+	},
+}
+
+var gitPseudoCmd = &cobra.Command{
+	Use:   "pseudo",
+	Short: "Print the Go pseudo-version of HEAD",
+	Long: `Print the Go pseudo-version of HEAD based on the latest version tag reachable from it.
+If HEAD is tagged with the latest version, the version itself is printed.
+
+Examples:
+  gosemver git pseudo
+  gosemver git pseudo --component services/api
+`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		repo := git.Repo{}
+
+		tag, latest, commits := latestTag(repo)
+		if tag != "" && commits == 0 {
+			fmt.Printf("v%s\n", latest)
+
+			return
+		}
+
+		hash, commitTime, err := repo.Head()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(c.ExitOtherErrors)
+		}
+
+		base := ""
+		if tag != "" {
+			base = latest.String()
+		}
+		printPseudoVersion(0, base, commitTime, hash)
+	},
+}
+
+// parseCommitTime parses RFC 3339, pseudo-version yyyymmddhhmmss and Unix seconds timestamps.
+func parseCommitTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+
+	if t, err := time.Parse("20060102150405", s); err == nil {
+		return t, nil
+	}
+
+	if seconds, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(seconds, 0), nil
+	}
+
+	return time.Time{}, fmt.Errorf("%w: %s", errInvalidCommitTime, s)
+}
+
+func printPseudoVersion(major int, base string, commitTime time.Time, revision string) {
+	pseudo, err := gosemver.NewPseudoVersion(major, base, commitTime, revision)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		if errors.Is(err, gosemver.ErrInvalidVersion) {
+			os.Exit(c.ExitInvalidSemver)
+		}
+		os.Exit(c.ExitOtherErrors)
+	}
+	fmt.Println(pseudo)
+}
+
+func init() {
+	rootCmd.AddCommand(pseudoCmd)
+	pseudoCmd.AddCommand(pseudoParseCmd, pseudoNewCmd)
+	gitCmd.AddCommand(gitPseudoCmd)
+	pseudoNewCmd.Flags().StringVar(
+		&pseudoBase,
+		"base",
+		"",
+		`Version the commit is based on`,
+	)
+	pseudoNewCmd.Flags().IntVar(
+		&pseudoMajor,
+		"major",
+		0,
+		`Major version used without a base`,
+	)
+}
@@ -0,0 +1,353 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	c "github.com/andreygrechin/gosemver/internal/config"
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+	"github.com/spf13/cobra"
+)
+
+var (
+	rangeFrom              string
+	rangeTo                string
+	rangeSyntax            string
+	rangeIgnorePrereleases bool
+)
+
+const rangeSyntaxes = `Syntaxes:
+  native  the npm syntax, see 'gosemver filter --help', e.g. '>=1.2.0 <2.0.0 || ^3.1'
+  npm     the same as native
+  cargo   Cargo requirements, a version without an operator is a caret requirement
+  maven   Maven version ranges, e.g. '[1.2,2.0)' or '(,1.0],[1.2,)'
+  gem     RubyGems requirements, e.g. '~> 2.2, >= 2.2.1', '~>' allows the last given part to
+          increase and a version without an operator is exact
+  pip     Python version specifiers on semantic versions, e.g. '~=1.4.5, <1.4.9', '~=' allows
+          the last given part to increase and '==1.4.*' matches the 1.4 versions
+  go      a go.mod minimum version, 'v1.2.3' allows any later v1 version and 'v0.4.0' any
+          later v0 or v1 version
+`
+
+const rangeComparison = `Constraints are compared as the sets of versions they match, prereleases following the rules of
+the syntax: Maven ranges and go.mod versions match them by precedence, the other syntaxes only
+prereleases of the versions a range names.
+
+`
+
+var rangeCmd = &cobra.Command{
+	Use:   "range",
+	Short: "Work with version constraints",
+	Long: `Work with version constraints as sets of version intervals.
+
+` + rangeSyntaxes,
+}
+
+var rangeConvertCmd = &cobra.Command{
+	Use:   "convert <constraint>",
+	Short: "Translate a constraint between ecosystem syntaxes",
+	Long: `Translate <constraint> from the '--from' syntax to the '--to' syntax. The result matches exactly the
+versions <constraint> matches, prereleases included: Maven ranges and go.mod versions match prereleases
+by precedence, the other syntaxes only prereleases of the versions a range names. Fails if the target
+syntax cannot express the same versions, e.g. alternatives in Cargo, an upper bound other than the next
+major version in go.mod or the prereleases of a Maven range in npm.
+
+With '--ignore-prereleases' only the releases have to match, and the result matches whichever
+prereleases the target syntax matches along with them: '^1.2.3' becomes '[1.2.3,2.0.0-0)' in Maven,
+which also matches 1.3.0-SNAPSHOT.
+
+` + rangeSyntaxes + `
+Examples:
+  gosemver range convert --from npm --to cargo '^1.2.3'
+  gosemver range convert --from maven --to go '[1.4.0,2.0.0-0)'
+  gosemver range convert --from gem --to pip '~> 2.2, >= 2.2.1'
+  gosemver range convert --ignore-prereleases --from npm --to maven '^1.2.3'
+  gosemver range convert --ignore-prereleases --from maven --to npm '[1.2,2.0)'
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		res := Result{Input: args}
+
+		convert := gosemver.ConvertConstraint
+		if rangeIgnorePrereleases {
+			convert = gosemver.ConvertReleases
+		}
+
+		converted, err := convert(args[0], rangeFrom, rangeTo)
+		if err != nil {
+			message := fmt.Sprintf("Error: %v", err)
+			if _, releasesErr := gosemver.ConvertReleases(args[0], rangeFrom, rangeTo); !rangeIgnorePrereleases &&
+				releasesErr == nil {
+				message += ", use '--ignore-prereleases' to match the releases only"
+			}
+			exitWithResult(res, message, c.ExitOtherErrors)
+		}
+		res.Result = converted
+		printResult(res, converted)
+	},
+}
+
+var rangeIntersectCmd = &cobra.Command{
+	Use:   "intersect <constraint> <constraint>...",
+	Short: "Print the versions satisfying all constraints",
+	Long: `Print a constraint satisfied by exactly the versions satisfying all constraints. Exits with status
+1 if no version satisfies them, printing "empty" and the conflicting bounds proving it to stderr.
+
+` + rangeComparison + rangeSyntaxes + `
+Examples:
+  gosemver range intersect '^1.2.0' '>=1.4.0 || ^2.0.0'
+  gosemver range intersect --syntax maven '[1.0,2.0)' '[1.5,3.0)'
+`,
+	Args: cobra.MinimumNArgs(2), //nolint:mnd
+	Run: func(cmd *cobra.Command, args []string) {
+		res := Result{Input: args}
+		constraints := parseRangeConstraints(res, args)
+
+		set := constraints[0].VersionSet()
+		if set.IsEmpty() {
+			res.Proof = emptyProof(constraints[0])
+		}
+		for i, constraint := range constraints[1:] {
+			if set.IsEmpty() {
+				break
+			}
+
+			next := constraint.VersionSet()
+			intersection := set.Intersect(next)
+			switch {
+			case next.IsEmpty():
+				res.Proof = emptyProof(constraint)
+			case intersection.IsEmpty():
+				if formatted, err := gosemver.FormatConstraint(set, rangeSyntax); i > 0 && err == nil {
+					res.Proof = append(res.Proof,
+						fmt.Sprintf("the constraints before %q allow only %s", args[i+1], formatted))
+				}
+				if conflicts := gosemver.IntersectConflicts(set, next); conflicts != nil {
+					res.Proof = append(res.Proof, conflictProof(conflicts)...)
+				} else {
+					res.Proof = append(res.Proof, fmt.Sprintf(
+						"the versions %q shares with the constraints before it by precedence are prereleases not all of them match",
+						args[i+1]))
+				}
+			}
+			set = intersection
+		}
+
+		res.Result = formatRangeSet(res, set)
+		if set.IsEmpty() {
+			res.Error = "no version satisfies all constraints"
+			exitWithResult(res, strings.Join(append([]string{"empty"}, res.Proof...), "\n"), c.ExitInvalidSemver)
+		}
+		printResult(res, res.Result.(string))
+	},
+}
+
+var rangeUnionCmd = &cobra.Command{
+	Use:   "union <constraint> <constraint>...",
+	Short: "Print the versions satisfying any of the constraints",
+	Long: `Print a constraint satisfied by exactly the versions satisfying any of the constraints.
+
+` + rangeComparison + rangeSyntaxes + `
+Examples:
+  gosemver range union '^1.2.0' '>=1.9.0 <2.5.0'
+  gosemver range union --syntax maven '[1.0,2.0)' '[2.0,3.0)'
+`,
+	Args: cobra.MinimumNArgs(2), //nolint:mnd
+	Run: func(cmd *cobra.Command, args []string) {
+		res := Result{Input: args}
+		constraints := parseRangeConstraints(res, args)
+
+		set := constraints[0].VersionSet()
+		for _, constraint := range constraints[1:] {
+			set = set.Union(constraint.VersionSet())
+		}
+
+		formatted := formatRangeSet(res, set)
+		res.Result = formatted
+		printResult(res, formatted)
+	},
+}
+
+var rangeSubsetCmd = &cobra.Command{
+	Use:   "subset <constraint> <superset>",
+	Short: "Check whether all versions satisfying a constraint satisfy another one",
+	Long: `Check whether every version satisfying <constraint> satisfies <superset>. Exits with status 0 if it
+does, printing "true" to stdout, or 1 if it does not, printing "false" and a version satisfying only
+<constraint> to stderr.
+
+` + rangeComparison + rangeSyntaxes + `
+Examples:
+  gosemver range subset '~1.2.3' '^1.0.0'
+  gosemver range subset --syntax cargo '1.2' '>=1.0, <3'
+`,
+	Args: cobra.ExactArgs(2), //nolint:mnd
+	Run: func(cmd *cobra.Command, args []string) {
+		res := Result{Input: args}
+		constraints := parseRangeConstraints(res, args)
+
+		difference := constraints[0].VersionSet().Difference(constraints[1].VersionSet())
+		res.Result = difference.IsEmpty()
+		if !difference.IsEmpty() {
+			if witness, ok := difference.Witness(); ok {
+				res.Proof = []string{fmt.Sprintf("%s satisfies %q but not %q", witness, args[0], args[1])}
+			}
+			exitWithResult(res, strings.Join(append([]string{"false"}, res.Proof...), "\n"), c.ExitInvalidSemver)
+		}
+		printResult(res, "true")
+	},
+}
+
+var rangeEmptyCmd = &cobra.Command{
+	Use:   "empty <constraint>",
+	Short: "Check whether no version satisfies a constraint",
+	Long: `Check whether no version satisfies <constraint>. Exits with status 0 if none does, printing "true"
+and the conflicting bounds of every alternative to stdout, or 1 if one does, printing "false" and a
+satisfying version to stderr.
+
+` + rangeComparison + rangeSyntaxes + `
+Examples:
+  gosemver range empty '>=2.0.0 <1.5.0 || >3.0.0 <=3.0.0'
+  gosemver range empty --syntax maven '(1.0,1.0)'
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		res := Result{Input: args}
+
+		constraint := parseRangeConstraints(res, args)[0]
+
+		set := constraint.VersionSet()
+		res.Result = set.IsEmpty()
+		if !set.IsEmpty() {
+			if witness, ok := set.Witness(); ok {
+				res.Proof = []string{fmt.Sprintf("%s satisfies %q", witness, args[0])}
+			}
+			exitWithResult(res, strings.Join(append([]string{"false"}, res.Proof...), "\n"), c.ExitInvalidSemver)
+		}
+
+		res.Proof = emptyProof(constraint)
+		printResult(res, strings.Join(append([]string{"true"}, res.Proof...), "\n"))
+	},
+}
+
+var rangeSimplifyCmd = &cobra.Command{
+	Use:   "simplify <constraint> [version...]",
+	Short: "Print the shortest equivalent constraint",
+	Long: `Print the shortest constraint in the '--syntax' syntax matching the same versions as <constraint>,
+prereleases included. The constraint is normalized into sorted, merged ranges. In the native syntax
+each is written as the shortest of an exact version, an x-range, a caret or tilde range, a hyphen range
+or comparators, other syntaxes get plain comparators. Fails if the syntax cannot match the same
+prereleases, as the native syntax for most Maven ranges.
+
+With versions given as arguments, via stdin when using '-' as the argument, or read from
+'--versions-file', the result only has to match the same versions among them, as npm's simplifyRange
+does: runs of consecutive matching versions become exact versions, hyphen ranges or open ranges at the
+ends of the list. The shorter of both results is printed.
+
+` + rangeSyntaxes + `
+Examples:
+  gosemver range simplify '>=1.0.0 <3.0.0 || >=1.5.0 <2.0.0 || 2.1.x'
+  gosemver range simplify '>=1.2.0 <2.0.0-0 || 1.5.x'
+  gosemver range simplify '1.0.2 || 1.0.3 || 1.1.0 || 1.2.0' 1.0.1 1.0.2 1.0.3 1.1.0 1.2.0 2.0.0
+  gosemver range simplify --syntax maven '[1.0,2.0),[1.5,3.0)'
+  git tag | gosemver range simplify '>=1.4.0 <2.0.0' -
+`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if versionsFile != "" {
+			return cobra.ExactArgs(1)(cmd, args)
+		}
+
+		return cobra.MinimumNArgs(1)(cmd, args)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		res := Result{Input: args}
+		constraint := parseRangeConstraints(res, args[:1])[0]
+
+		var versions []*gosemver.SemVer
+		for _, version := range inputVersions(cmd, args[1:]) {
+			ver, err := gosemver.ParseSemVer(version)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: skipping %v\n", err)
+
+				continue
+			}
+			versions = append(versions, ver)
+		}
+
+		simplified, err := gosemver.SimplifyConstraint(constraint, versions, rangeSyntax)
+		if err != nil {
+			exitWithResult(res, fmt.Sprintf("Error: %v", err), c.ExitOtherErrors)
+		}
+		res.Result = simplified
+		printResult(res, simplified)
+	},
+}
+
+// parseRangeConstraints parses the constraints in '--syntax', exiting on the first invalid one.
+func parseRangeConstraints(res Result, constraints []string) []*gosemver.Constraint {
+	parsed := make([]*gosemver.Constraint, 0, len(constraints))
+
+	for _, constraint := range constraints {
+		p, err := gosemver.ParseConstraintSyntax(constraint, rangeSyntax)
+		if err != nil {
+			exitWithResult(res, fmt.Sprintf("Error: %v", err), c.ExitOtherErrors)
+		}
+		parsed = append(parsed, p)
+	}
+
+	return parsed
+}
+
+func conflictProof(conflicts []gosemver.Conflict) []string {
+	proof := make([]string, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		proof = append(proof, conflict.String())
+	}
+
+	return proof
+}
+
+// emptyProof explains why no version satisfies a constraint with a line for every range.
+func emptyProof(constraint *gosemver.Constraint) []string {
+	proof := make([]string, 0, len(constraint.Ranges))
+
+	for _, r := range constraint.Ranges {
+		single := &gosemver.Constraint{Ranges: []gosemver.Range{r}, IncludePrerelease: constraint.IncludePrerelease}
+		if conflicts := single.Conflicts(); conflicts != nil {
+			proof = append(proof, conflictProof(conflicts)...)
+		} else {
+			proof = append(proof, fmt.Sprintf("%s holds only prereleases of versions none of its comparators names", r))
+		}
+	}
+
+	return proof
+}
+
+// formatRangeSet formats a version set in '--syntax', exiting if the syntax cannot express it.
+func formatRangeSet(res Result, set gosemver.VersionSet) string {
+	formatted, err := gosemver.FormatConstraint(set, rangeSyntax)
+	if err != nil {
+		exitWithResult(res, fmt.Sprintf("Error: %v", err), c.ExitOtherErrors)
+	}
+
+	return formatted
+}
+
+func init() {
+	rootCmd.AddCommand(rangeCmd)
+	rangeCmd.AddCommand(rangeConvertCmd)
+	rangeConvertCmd.Flags().StringVar(&rangeFrom, "from", gosemver.SyntaxNative, `Syntax of the constraint`)
+	rangeConvertCmd.Flags().StringVar(&rangeTo, "to", "", `Syntax to translate the constraint to`)
+	rangeConvertCmd.Flags().BoolVar(&rangeIgnorePrereleases, "ignore-prereleases", false,
+		`Match the releases only, prereleases following the target syntax`)
+	_ = rangeConvertCmd.MarkFlagRequired("to")
+
+	for _, cmd := range []*cobra.Command{rangeIntersectCmd, rangeUnionCmd, rangeSubsetCmd, rangeEmptyCmd} {
+		rangeCmd.AddCommand(cmd)
+		cmd.Flags().StringVar(&rangeSyntax, "syntax", gosemver.SyntaxNative,
+			`Syntax of the constraints and the result: native, npm, cargo, maven, gem, pip or go`)
+	}
+	rangeCmd.AddCommand(rangeSimplifyCmd)
+	rangeSimplifyCmd.Flags().StringVar(&rangeSyntax, "syntax", gosemver.SyntaxNative, `Syntax of the constraint`)
+	rangeSimplifyCmd.Flags().StringVar(&versionsFile, "versions-file", "", `Read the versions from a file, one per line`)
+}
@@ -10,14 +10,115 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var (
+	configFile    string
+	projectConfig = c.DefaultProject()
+)
+
 var rootCmd = &cobra.Command{
 	Use: "gosemver",
 	Long: `gosemver: A command-line utility and a library for validating, comparing, and manipulating semantic
 versions, fully adhering to the Semantic Versioning 2.0.0 specification.
 
+Project defaults are read from .gosemver.yaml or .gosemver.toml in the current directory or its parents
+up to the repository root, or from the file given by '--config' or GOSEMVER_CONFIG. Environment variables
+GOSEMVER_TAG_PREFIX, GOSEMVER_PRERELEASE_STAGES, GOSEMVER_PRERELEASE_NUMBERING, GOSEMVER_VERSION_FILES,
+GOSEMVER_COMMIT_TYPES and GOSEMVER_OUTPUT override the file, command-line flags override both.
+
+With '--output json' or '--output yaml' the commands validate, compare, diff, bump, get, convert,
+format, docker-tags, max, min, latest, filter, next, satisfies, range, osv and sbom print an object
+with the fields:
+  input    the input versions
+  version  the parsed first input version or the selected version, omitted if invalid
+  result   true or false for validate and satisfies, -1, 0 or 1 for compare, the identifier for
+           diff, the new version for bump, the value for get, the converted version for convert,
+           the rendered text for format, the list of tags for docker-tags, the selected version for
+           max, min and latest, the matching versions for filter, a list of kind and version
+           objects for next, the resulting constraint for range convert, intersect, union and
+           simplify, true or false for range subset and empty, the list of affected packages with
+           the advisory id and fix for osv check, the list of components named in the policy with
+           their status for sbom check
+  proof    the conflicting bounds of an empty range or a version telling ranges apart, for range
+  error    the reason of a failure, omitted on success
+  line     the number of the input line with '--batch'
+Errors are reported in the object on stdout, the exit status is the same as with text output.
+Other commands print text only and reject '--output' and '--template', an output format set by the
+project configuration does not apply to them.
+With '--template' the result is rendered by a Go template against the resulting version with the
+fields Major, Minor, Patch, Prerelease, Build and Release.
+
 See also:
   - https://semver.org
 `,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		if skipsConfig(cmd) {
+			return
+		}
+
+		project, err := c.LoadProject(configFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(c.ExitOtherErrors)
+		}
+		projectConfig = project
+
+		applyConfigDefaults(cmd)
+
+		if err := checkOutputFlags(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(c.ExitOtherErrors)
+		}
+
+		if err := checkOutputSupport(cmd); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(c.ExitOtherErrors)
+		}
+	},
+}
+
+// skipsConfig reports whether a command works without the project configuration, so that a broken
+// configuration file does not break the help and version commands.
+func skipsConfig(cmd *cobra.Command) bool {
+	for ; cmd != nil; cmd = cmd.Parent() {
+		switch cmd.Name() {
+		case "help", "version", "completion":
+			return true
+		}
+	}
+
+	return false
+}
+
+// applyConfigDefaults sets flags not given on the command line to the project configuration. The
+// prerelease settings apply through defaultPrereleaseID, as a prerelease bump of a prerelease keeps
+// its ID rather than taking a flag default.
+func applyConfigDefaults(cmd *cobra.Command) {
+	defaults := map[string]string{
+		"tag-prefix": projectConfig.TagPrefix,
+		"output":     projectConfig.Output,
+	}
+
+	for name, value := range defaults {
+		flag := cmd.Flags().Lookup(name)
+		if flag == nil || flag.Changed {
+			continue
+		}
+
+		if err := flag.Value.Set(value); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid configuration for '--%s': %v\n", name, err)
+			os.Exit(c.ExitOtherErrors)
+		}
+	}
+}
+
+// defaultPrereleaseID returns the ID starting the prereleases of a release: the first prerelease
+// stage of the project configuration numbered by its numbering setting, or empty without stages.
+func defaultPrereleaseID() string {
+	if len(projectConfig.Prerelease.Stages) == 0 {
+		return ""
+	}
+
+	return projectConfig.Prerelease.Stages[0] + projectConfig.SeparatorForNumbering() + "1"
 }
 
 func Execute() {
@@ -33,3 +134,25 @@ func Execute() {
 		os.Exit(c.ExitOtherErrors)
 	}
 }
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(
+		&configFile,
+		"config",
+		"",
+		`Path of the project configuration file`,
+	)
+	rootCmd.PersistentFlags().StringVarP(
+		&outputFormat,
+		"output",
+		"o",
+		outputText,
+		`Output format of results: text, json, yaml or template`,
+	)
+	rootCmd.PersistentFlags().StringVar(
+		&templateText,
+		"template",
+		"",
+		`Go template executed against the resulting SemVer, implies '--output template'`,
+	)
+}
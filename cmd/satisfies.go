@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"fmt"
+
+	c "github.com/andreygrechin/gosemver/internal/config"
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+	"github.com/spf13/cobra"
+)
+
+var constraintSyntax string
+
+var satisfiesCmd = &cobra.Command{
+	Use:   "satisfies <constraint> <version|->",
+	Short: "Check whether a version satisfies a constraint",
+	Long: `Check whether <version> satisfies <constraint>. Exits with status 0 if it does, 1 if it does not.
+Prints "true" to stdout or "false" to stderr.
+
+The constraint is written in the syntax given by '--syntax', a Maven version without brackets is a
+minimum version and Maven versions like '1.0-SNAPSHOT' are read as '1.0.0-SNAPSHOT'.
+
+` + rangeSyntaxes + `
+The version can be provided either as an argument, via stdin when using '-' as the argument, or read
+from a project file with '--file'. Only one input method can be used at a time.
+
+Examples:
+  gosemver satisfies '>=1.2.0 <2.0.0' 1.4.0
+  gosemver satisfies --syntax maven '[1.2,2.0)' 1.4.0
+  gosemver satisfies --syntax cargo '0.2.3' 0.2.9
+  gosemver satisfies --syntax gem '~> 2.2, >= 2.2.1' 2.4.0
+  gosemver satisfies --syntax pip '~=1.4.5' 1.4.9
+  gosemver satisfies '1.x' --file package.json
+`,
+	Args: argsWithFile(2), //nolint:mnd
+	Run: func(cmd *cobra.Command, args []string) {
+		version := inputVersion(cmd, args)
+		res := newResult(version)
+		if res.Version == nil {
+			_, err := gosemver.ParseSemVer(version)
+			exitWithError(res, err)
+		}
+
+		constraint, err := gosemver.ParseConstraintSyntax(args[0], constraintSyntax)
+		if err != nil {
+			exitWithResult(res, fmt.Sprintf("Error: %v", err), c.ExitOtherErrors)
+		}
+
+		satisfied := constraint.Check(res.Version)
+		res.Result = satisfied
+		if !satisfied {
+			exitWithResult(res, "false", c.ExitInvalidSemver)
+		}
+		printResult(res, "true")
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(satisfiesCmd)
+	addFileFlag(satisfiesCmd, false)
+	satisfiesCmd.Flags().StringVar(
+		&constraintSyntax,
+		"syntax",
+		gosemver.SyntaxNative,
+		`Syntax of the constraint: native, npm, cargo, maven, gem, pip or go`,
+	)
+}
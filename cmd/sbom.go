@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	c "github.com/andreygrechin/gosemver/internal/config"
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+	"github.com/spf13/cobra"
+)
+
+var sbomPolicy string
+
+var sbomCmd = &cobra.Command{
+	Use:   "sbom",
+	Short: "Work with software bills of materials",
+	Long:  `Work with software bills of materials in the CycloneDX and SPDX JSON formats.`,
+}
+
+var sbomCheckCmd = &cobra.Command{
+	Use:   "check <sbom.json>",
+	Short: "Check component versions of an SBOM against a policy",
+	Long: `Check the versions of the components of a CycloneDX or SPDX JSON SBOM against the constraints of a
+policy. Exits with status 0 if all components named in the policy satisfy their constraints, or 1 if
+any does not, is missing from the SBOM or has a version which cannot be read as a semantic version,
+printing a line per problem.
+
+Versions are coerced to semantic versions: a leading 'v' and leading zeros are dropped, missing minor
+and patch numbers are zero and a qualifier like in '1.0-SNAPSHOT' or '2.5.3.Final' becomes the
+prerelease, unless it marks a release like 'Final', 'RELEASE' or 'GA'.
+
+The policy is a YAML or JSON file mapping component names to constraints, in the syntax given by
+'syntax'. CycloneDX components with a group are named '<group>/<name>'.
+
+  syntax: native
+  components:
+    openssl: '>=3.0.7'
+    org.apache.logging.log4j/log4j-core: '>=2.17.1'
+
+` + rangeSyntaxes + `
+Examples:
+  gosemver sbom check bom.cdx.json --policy policy.yaml
+  gosemver sbom check --output json sbom.spdx.json --policy policy.yaml
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		res := Result{Input: args}
+
+		policy, err := gosemver.ReadVersionPolicy(sbomPolicy)
+		if err != nil {
+			exitWithResult(res, fmt.Sprintf("Error: %v", err), c.ExitOtherErrors)
+		}
+
+		components, err := gosemver.ReadSBOM(args[0])
+		if err != nil {
+			exitWithResult(res, fmt.Sprintf("Error: %v", err), c.ExitOtherErrors)
+		}
+
+		checks, err := policy.Check(components)
+		if err != nil {
+			exitWithResult(res, fmt.Sprintf("Error: %v", err), c.ExitOtherErrors)
+		}
+		res.Result = checks
+
+		var problems []string
+		for _, check := range checks {
+			switch check.Status {
+			case gosemver.ComponentViolation:
+				problems = append(problems, fmt.Sprintf("%s\t%s\t%s\tdoes not satisfy %s",
+					check.Status, check.Name, check.Version, check.Constraint))
+			case gosemver.ComponentMissing:
+				problems = append(problems, fmt.Sprintf("%s\t%s", check.Status, check.Name))
+			case gosemver.ComponentUnparseable:
+				problems = append(problems, fmt.Sprintf("%s\t%s\t%s", check.Status, check.Name, check.Version))
+			}
+		}
+
+		if len(problems) == 0 {
+			printResult(res, fmt.Sprintf("%d components satisfy the policy", len(checks)))
+
+			return
+		}
+		printResult(res, strings.Join(problems, "\n"))
+		os.Exit(c.ExitInvalidSemver)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(sbomCmd)
+	sbomCmd.AddCommand(sbomCheckCmd)
+	sbomCheckCmd.Flags().StringVar(&sbomPolicy, "policy", "", `Policy file with the constraints of the components`)
+	_ = sbomCheckCmd.MarkFlagRequired("policy")
+}
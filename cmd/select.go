@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	c "github.com/andreygrechin/gosemver/internal/config"
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+	"github.com/spf13/cobra"
+)
+
+var (
+	versionsFile    string
+	stableOnly      bool
+	constraintExpr  string
+	majorScope      int
+	errNoCandidates = errors.New("no version matches")
+)
+
+const selectLong = `The versions can be provided as arguments, via stdin one per line when using '-' as the argument,
+or read from a file one per line with '--versions-file'. Invalid versions are skipped with a warning.
+Exits with status 1 if no version matches the filters.
+
+Candidates can be narrowed with '--stable-only', '--major' and '--constraint', e.g. '>=1.2.0 <2.0.0',
+'1.x || 2.0.x' or '1.2.3 - 2.3.4', see 'gosemver filter --help' for the constraint syntax.
+`
+
+var maxCmd = &cobra.Command{
+	Use:   "max <version...|->",
+	Short: "Select the highest version",
+	Long: `Select the version with the highest precedence. Of versions with equal precedence the first one is
+selected.
+
+` + selectLong + `
+Examples:
+  gosemver max 1.2.0 1.10.0 v1.9.3
+  git tag | gosemver max - --stable-only --major 1
+`,
+	Args: selectArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		runSelect(cmd, args, gosemver.MaxVersion)
+	},
+}
+
+var minCmd = &cobra.Command{
+	Use:   "min <version...|->",
+	Short: "Select the lowest version",
+	Long: `Select the version with the lowest precedence. Of versions with equal precedence the first one is
+selected.
+
+` + selectLong + `
+Examples:
+  gosemver min 1.2.0 1.10.0 v1.9.3
+  gosemver min --versions-file tags.txt --constraint '>=2.0.0'
+`,
+	Args: selectArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		runSelect(cmd, args, gosemver.MinVersion)
+	},
+}
+
+var latestCmd = &cobra.Command{
+	Use:   "latest <version...|->",
+	Short: "Select the highest release, or prerelease if there are no releases",
+	Long: `Select the highest release, ignoring prereleases unless there are no releases at all.
+
+` + selectLong + `
+Examples:
+  gosemver latest 1.2.0 2.0.0-rc.1 1.10.0
+  git tag | gosemver latest - --constraint '1.x'
+`,
+	Args: selectArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		runSelect(cmd, args, gosemver.LatestVersion)
+	},
+}
+
+// selectArgs expects versions as arguments unless they are read from '--versions-file'.
+func selectArgs(cmd *cobra.Command, args []string) error {
+	if versionsFile != "" {
+		return cobra.NoArgs(cmd, args)
+	}
+
+	return cobra.MinimumNArgs(1)(cmd, args)
+}
+
+// runSelect prints the version chosen by selectVersion among the input versions matching the filters.
+func runSelect(cmd *cobra.Command, args []string, selectVersion func([]string) (string, error)) {
+	versions := inputVersions(cmd, args)
+	res := Result{Input: versions}
+
+	candidates, err := filterCandidates(cmd, versions)
+	if err != nil {
+		exitWithResult(res, fmt.Sprintf("Error: %v", err), c.ExitOtherErrors)
+	}
+
+	selected, err := selectVersion(candidates)
+	if errors.Is(err, gosemver.ErrNoVersions) {
+		exitWithResult(res, fmt.Sprintf("Error: %v", errNoCandidates), c.ExitInvalidSemver)
+	}
+	if err != nil {
+		exitWithError(res, err)
+	}
+
+	res.Version, _ = gosemver.ParseSemVer(selected)
+	res.data = res.Version
+	res.Result = selected
+	printResult(res, selected)
+}
+
+// inputVersions returns the versions from '--versions-file', stdin or the arguments.
+func inputVersions(cmd *cobra.Command, args []string) []string {
+	path := versionsFile
+	if len(args) == 1 && args[0] == "-" {
+		path = "-"
+	}
+	if path == "" {
+		return args
+	}
+
+	versions, err := readLines(cmd, path)
+	if err != nil {
+		exitWithResult(Result{Input: []string{}}, fmt.Sprintf("Failed to read versions: %v", err), c.ExitOtherErrors)
+	}
+
+	return versions
+}
+
+// filterCandidates returns the valid versions matching '--stable-only', '--major' and '--constraint'.
+func filterCandidates(cmd *cobra.Command, versions []string) ([]string, error) {
+	var constraint *gosemver.Constraint
+	if constraintExpr != "" {
+		var err error
+		if constraint, err = gosemver.ParseConstraint(constraintExpr); err != nil {
+			return nil, err
+		}
+	}
+	scoped := cmd.Flags().Changed("major")
+
+	candidates := make([]string, 0, len(versions))
+	for _, version := range versions {
+		ver, err := gosemver.ParseSemVer(version)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: skipping %v\n", err)
+
+			continue
+		}
+		if stableOnly && ver.Prerelease != "" {
+			continue
+		}
+		if scoped && ver.Major != majorScope {
+			continue
+		}
+		if constraint != nil && !constraint.Check(ver) {
+			continue
+		}
+		candidates = append(candidates, version)
+	}
+
+	return candidates, nil
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{maxCmd, minCmd, latestCmd} {
+		rootCmd.AddCommand(cmd)
+		cmd.Flags().StringVar(&versionsFile, "versions-file", "", `Read the versions from a file, one per line`)
+		cmd.Flags().BoolVar(&stableOnly, "stable-only", false, `Ignore prereleases`)
+		cmd.Flags().StringVar(&constraintExpr, "constraint", "", `Only consider versions satisfying a constraint`)
+		cmd.Flags().IntVar(&majorScope, "major", 0, `Only consider versions with this major version`)
+	}
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	c "github.com/andreygrechin/gosemver/internal/config"
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+	"github.com/spf13/cobra"
+)
+
+var setCmd = &cobra.Command{
+	Use:   "set <version|-> --file <path>[:<selector>]",
+	Short: "Write a semantic version to a project file",
+	Long: `Write <version> to the location in a project file given by '--file', keeping the rest of the file
+untouched, and print the replaced version.
+
+The version can be provided either as an argument or via stdin when using '-' as the argument.
+Only one input method can be used at a time.
+
+Examples:
+  gosemver set 1.2.3 --file VERSION
+  gosemver set 1.2.3 --file package.json
+  gosemver set 1.2.3 --file internal/config/config.go:'regex:Version = "(.*)"'
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if inputFile == "" {
+			fmt.Fprintln(os.Stderr, "Error: The '--file' flag is required")
+			os.Exit(c.ExitOtherErrors)
+		}
+
+		version, err := gosemver.GetLastArg(*cmd, args)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to get arguments: %v\n", err)
+			os.Exit(c.ExitOtherErrors)
+		}
+		if !gosemver.IsSemVer(version) {
+			fmt.Fprintf(os.Stderr, "Error: %v: %s\n", gosemver.ErrInvalidVersion, version)
+			os.Exit(c.ExitInvalidSemver)
+		}
+
+		previous, err := gosemver.ParseVersionFile(inputFile).Read()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to read version: %v\n", err)
+			os.Exit(c.ExitOtherErrors)
+		}
+
+		writeVersion(version)
+		fmt.Println(previous)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(setCmd)
+	addFileFlag(setCmd, false)
+}
@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	c "github.com/andreygrechin/gosemver/internal/config"
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+	"github.com/spf13/cobra"
+)
+
+var (
+	syncVersion string
+	syncExact   bool
+)
+
+var syncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Keep versions in several project files in lockstep",
+	Long: `Keep versions in several project files in lockstep. Files are given as '<path>[:<selector>]', see
+'gosemver get --help' for the selector syntax. The first file is the source of truth unless the version
+is given with '--version'. Without arguments the 'version_files' of the project configuration are used.
+`,
+}
+
+var syncCheckCmd = &cobra.Command{
+	Use:   "check [file...]",
+	Short: "Report files whose version differs from the source of truth",
+	Long: `Report every file whose version differs from the source of truth. Versions are compared by
+precedence, or with '--exact' including prerelease and build metadata. A 'v' prefix is not significant.
+Outputs one tab-separated '<file> <version> <status>' line per file.
+Exits with status 0 if all files are in sync, 1 if not, 2 if a file cannot be read.
+
+Examples:
+  gosemver sync check VERSION package.json Chart.yaml:appVersion
+  gosemver sync check --version 1.2.3 --exact package.json Cargo.toml
+`,
+	Args: cobra.ArbitraryArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		version, files := syncSource(args)
+
+		statuses, err := gosemver.CheckSync(version, files, syncExact)
+		if errors.Is(err, gosemver.ErrInvalidVersion) {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(c.ExitInvalidSemver)
+		}
+
+		exitCode := c.ExitOK
+		for _, status := range statuses {
+			switch {
+			case status.Err != nil && !errors.Is(status.Err, gosemver.ErrInvalidVersion):
+				fmt.Printf("%s\t%s\terror: %v\n", status.File, status.Version, status.Err)
+				exitCode = c.ExitOtherErrors
+			case !status.InSync:
+				fmt.Printf("%s\t%s\tout of sync\n", status.File, status.Version)
+				exitCode = max(exitCode, c.ExitInvalidSemver)
+			default:
+				fmt.Printf("%s\t%s\tok\n", status.File, status.Version)
+			}
+		}
+		os.Exit(exitCode)
+	},
+}
+
+var syncApplyCmd = &cobra.Command{
+	Use:   "apply [file...]",
+	Short: "Write the source of truth to all files",
+	Long: `Write the version of the source of truth to all files, keeping a 'v' prefix where a file already
+uses one. Nothing is written if any of the files cannot be updated.
+
+Examples:
+  gosemver sync apply VERSION package.json Chart.yaml:appVersion
+  gosemver sync apply --version 1.2.3 package.json Cargo.toml
+`,
+	Args: cobra.ArbitraryArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		version, files := syncSource(args)
+
+		if err := gosemver.ApplySync(version, files); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			if errors.Is(err, gosemver.ErrInvalidVersion) {
+				os.Exit(c.ExitInvalidSemver)
+			}
+			os.Exit(c.ExitOtherErrors)
+		}
+		fmt.Println(version)
+	},
+}
+
+// syncSource returns the source of truth and the files to keep in sync with it.
+func syncSource(args []string) (string, []gosemver.VersionFile) {
+	if len(args) == 0 {
+		args = projectConfig.VersionFiles
+	}
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, "Error: no files given and no version_files configured")
+		os.Exit(c.ExitOtherErrors)
+	}
+
+	files := make([]gosemver.VersionFile, 0, len(args))
+	for _, arg := range args {
+		files = append(files, gosemver.ParseVersionFile(arg))
+	}
+
+	if syncVersion != "" {
+		return syncVersion, files
+	}
+
+	version, err := files[0].Read()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to read version: %v\n", err)
+		os.Exit(c.ExitOtherErrors)
+	}
+
+	return version, files[1:]
+}
+
+func init() {
+	rootCmd.AddCommand(syncCmd)
+	syncCmd.AddCommand(syncCheckCmd, syncApplyCmd)
+	syncCmd.PersistentFlags().StringVar(
+		&syncVersion,
+		"version",
+		"",
+		`Use this version as the source of truth instead of the first file`,
+	)
+	syncCheckCmd.Flags().BoolVar(
+		&syncExact,
+		"exact",
+		false,
+		`Compare prerelease and build metadata too, not only precedence`,
+	)
+}
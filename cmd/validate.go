@@ -2,7 +2,6 @@ package cmd
 
 import (
 	"fmt"
-	"os"
 
 	c "github.com/andreygrechin/gosemver/internal/config"
 	"github.com/andreygrechin/gosemver/pkg/gosemver"
@@ -13,40 +12,46 @@ var validateCmd = &cobra.Command{
 	Use:   "validate <version|->",
 	Short: "Validate a semantic version",
 	Long: `Validate whether a provided version string complies with the Semantic Versioning 2.0.0 specification.
-Exits with status 0 if valid, 1 if invalid. Prints "valid" or "invalid" to stdout.
+Exits with status 0 if valid, 1 if invalid. Prints "valid" to stdout or "invalid" to stderr.
 
-The version can be provided either as an argument or via stdin when using '-' as the argument.
-Only one input method can be used at a time.
+The version can be provided either as an argument, via stdin when using '-' as the argument, or read
+from a project file with '--file'. Only one input method can be used at a time. With '--batch' every
+line of stdin is validated, invalid lines are reported to stderr.
 
 Examples:
   gosemver validate 1.2.3
   gosemver validate v1.2.3-beta.1+build.123
   echo "1.2.3" | gosemver validate -
+  gosemver validate --file pyproject.toml
+  git tag | gosemver validate --batch
 `,
-	Args: cobra.ExactArgs(1),
+	Args: batchArgs(0, argsWithFile(1)),
 	Run: func(cmd *cobra.Command, args []string) {
-		var version string
-		version, err := gosemver.GetLastArg(*cmd, args)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Failed to get arguments: %v\n", err)
-			os.Exit(c.ExitOtherErrors)
+		if batchMode {
+			runBatch(cmd, validateVersion)
 		}
 
-		if version == "" {
-			fmt.Fprintln(os.Stderr, "Error: version string is empty")
-			os.Exit(c.ExitOtherErrors)
-		}
+		exitWithOutcome(validateVersion(inputVersion(cmd, args)))
+	},
+}
 
-		if gosemver.IsSemVer(version) {
-			fmt.Println("valid")
-			os.Exit(c.ExitOK)
-		}
+func validateVersion(version string) outcome {
+	res := newResult(version)
 
-		fmt.Fprintln(os.Stderr, "invalid")
-		os.Exit(c.ExitInvalidSemver)
-	},
+	if gosemver.IsSemVer(version) {
+		res.Result = true
+
+		return succeeded(res, "valid")
+	}
+
+	res.Result = false
+	res.Error = fmt.Sprintf("%v: %s", gosemver.ErrInvalidVersion, version)
+
+	return failed(res, "invalid", c.ExitInvalidSemver)
 }
 
 func init() {
 	rootCmd.AddCommand(validateCmd)
+	addFileFlag(validateCmd, false)
+	addBatchFlag(validateCmd)
 }
@@ -0,0 +1,221 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// EnvPrefix is the prefix of environment variables overriding the project configuration.
+const EnvPrefix = "GOSEMVER_"
+
+// ConfigFiles are the names of project configuration files, in the order they are looked up.
+var ConfigFiles = []string{".gosemver.yaml", ".gosemver.yml", ".gosemver.toml"}
+
+var ErrInvalidConfig = errors.New("invalid configuration")
+
+// Project holds the project configuration.
+type Project struct {
+	// TagPrefix is the prefix of version tags.
+	TagPrefix string `yaml:"tag_prefix" toml:"tag_prefix"`
+	// Prerelease configures prerelease identifiers started by 'bump prerelease'.
+	Prerelease Prerelease `yaml:"prerelease" toml:"prerelease"`
+	// VersionFiles lists '<path>[:<selector>]' locations of the version, the first one is the source of truth.
+	VersionFiles []string `yaml:"version_files" toml:"version_files"`
+	// CommitTypes maps conventional commit types to the version identifier they bump.
+	CommitTypes map[string]string `yaml:"commit_types" toml:"commit_types"`
+	// Output is the default output format.
+	Output string `yaml:"output" toml:"output"`
+
+	// Path is the file the configuration was loaded from, empty for defaults.
+	Path string `yaml:"-" toml:"-"`
+}
+
+// Prerelease configures prerelease identifiers.
+type Prerelease struct {
+	// Stages are the prerelease stages in order, a new prerelease starts with the first one.
+	Stages []string `yaml:"stages" toml:"stages"`
+	// Numbering is "dot" for 'alpha.1' or "none" for 'alpha1'.
+	Numbering string `yaml:"numbering" toml:"numbering"`
+}
+
+// DefaultProject returns the configuration used without a configuration file.
+func DefaultProject() *Project {
+	return &Project{
+		TagPrefix: "v",
+		Prerelease: Prerelease{
+			Numbering: "dot",
+		},
+		CommitTypes: map[string]string{
+			"feat": "minor",
+			"fix":  "patch",
+			"perf": "patch",
+		},
+		Output: "text",
+	}
+}
+
+// LoadProject loads the configuration from path, or from the first configuration file found in the
+// current directory or its parents up to the repository root if path is empty. Environment variables
+// override values from the file.
+func LoadProject(path string) (*Project, error) {
+	project := DefaultProject()
+
+	if path == "" {
+		path = os.Getenv(EnvPrefix + "CONFIG")
+	}
+
+	if path == "" {
+		var err error
+
+		path, err = findConfigFile()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if path != "" {
+		if err := project.load(path); err != nil {
+			return nil, err
+		}
+	}
+
+	project.loadEnv()
+
+	if err := project.validate(); err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+// SeparatorForNumbering returns the separator between a prerelease stage and its number.
+func (p *Project) SeparatorForNumbering() string {
+	if p.Prerelease.Numbering == "none" {
+		return ""
+	}
+
+	return "."
+}
+
+func (p *Project) load(path string) error {
+	content, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+	}
+
+	if strings.EqualFold(filepath.Ext(path), ".toml") {
+		_, err = toml.Decode(string(content), p)
+	} else {
+		err = yaml.Unmarshal(content, p)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrInvalidConfig, path, err)
+	}
+
+	p.Path = path
+
+	return nil
+}
+
+func (p *Project) loadEnv() {
+	if value, ok := os.LookupEnv(EnvPrefix + "TAG_PREFIX"); ok {
+		p.TagPrefix = value
+	}
+
+	if value, ok := os.LookupEnv(EnvPrefix + "PRERELEASE_STAGES"); ok {
+		p.Prerelease.Stages = splitList(value)
+	}
+
+	if value, ok := os.LookupEnv(EnvPrefix + "PRERELEASE_NUMBERING"); ok {
+		p.Prerelease.Numbering = value
+	}
+
+	if value, ok := os.LookupEnv(EnvPrefix + "VERSION_FILES"); ok {
+		p.VersionFiles = splitList(value)
+	}
+
+	if value, ok := os.LookupEnv(EnvPrefix + "COMMIT_TYPES"); ok {
+		p.CommitTypes = map[string]string{}
+
+		for _, mapping := range splitList(value) {
+			commitType, semverID, _ := strings.Cut(mapping, "=")
+			p.CommitTypes[strings.TrimSpace(commitType)] = strings.TrimSpace(semverID)
+		}
+	}
+
+	if value, ok := os.LookupEnv(EnvPrefix + "OUTPUT"); ok {
+		p.Output = value
+	}
+}
+
+func (p *Project) validate() error {
+	switch p.Prerelease.Numbering {
+	case "dot", "none":
+	default:
+		return fmt.Errorf("%w: prerelease numbering must be 'dot' or 'none', got %q", ErrInvalidConfig, p.Prerelease.Numbering)
+	}
+
+	switch p.Output {
+	case "text", "json", "yaml", "template":
+	default:
+		return fmt.Errorf("%w: output must be text, json, yaml or template, got %q", ErrInvalidConfig, p.Output)
+	}
+
+	for commitType, semverID := range p.CommitTypes {
+		switch semverID {
+		case "major", "minor", "patch", "none":
+		default:
+			return fmt.Errorf("%w: commit type %q must map to major, minor, patch or none, got %q",
+				ErrInvalidConfig, commitType, semverID)
+		}
+	}
+
+	return nil
+}
+
+// findConfigFile looks for a configuration file from the current directory up to the repository root.
+func findConfigFile() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	for {
+		for _, name := range ConfigFiles {
+			path := filepath.Join(dir, name)
+			if _, err := os.Stat(path); err == nil {
+				return path, nil
+			}
+		}
+
+		if _, err := os.Stat(filepath.Join(dir, ".git")); err == nil {
+			return "", nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", nil
+		}
+
+		dir = parent
+	}
+}
+
+func splitList(value string) []string {
+	var list []string
+
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+
+	return list
+}
@@ -0,0 +1,122 @@
+package config_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/andreygrechin/gosemver/internal/config"
+)
+
+func chdir(t *testing.T, dir string) {
+	t.Helper()
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := os.Chdir(wd); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestLoadProject(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		env     map[string]string
+		want    func(p *config.Project) bool
+		wantErr error
+	}{
+		{
+			"yaml", ".gosemver.yaml",
+			"tag_prefix: release-\nprerelease:\n  stages: [beta, rc]\nversion_files:\n  - VERSION\ncommit_types:\n  feat: major\n",
+			nil,
+			func(p *config.Project) bool {
+				return p.TagPrefix == "release-" && slices.Equal(p.Prerelease.Stages, []string{"beta", "rc"}) &&
+					p.Prerelease.Numbering == "dot" && slices.Equal(p.VersionFiles, []string{"VERSION"}) &&
+					p.CommitTypes["feat"] == "major" && p.CommitTypes["fix"] == "patch"
+			},
+			nil,
+		},
+		{
+			"toml", ".gosemver.toml",
+			"tag_prefix = \"\"\noutput = \"json\"\n[prerelease]\nnumbering = \"none\"\n",
+			nil,
+			func(p *config.Project) bool {
+				return p.TagPrefix == "" && p.Output == "json" && p.SeparatorForNumbering() == ""
+			},
+			nil,
+		},
+		{
+			"environment overrides file", ".gosemver.yaml",
+			"tag_prefix: release-\n",
+			map[string]string{
+				"GOSEMVER_TAG_PREFIX":        "v",
+				"GOSEMVER_PRERELEASE_STAGES": "alpha, beta",
+				"GOSEMVER_COMMIT_TYPES":      "feat=minor,docs=none",
+			},
+			func(p *config.Project) bool {
+				return p.TagPrefix == "v" && slices.Equal(p.Prerelease.Stages, []string{"alpha", "beta"}) &&
+					len(p.CommitTypes) == 2 && p.CommitTypes["docs"] == "none"
+			},
+			nil,
+		},
+		{
+			"defaults without file", "",
+			"",
+			nil,
+			func(p *config.Project) bool { return p.TagPrefix == "v" && p.Path == "" },
+			nil,
+		},
+		{"invalid yaml", ".gosemver.yaml", "tag_prefix: [", nil, nil, config.ErrInvalidConfig},
+		{"invalid numbering", ".gosemver.yaml", "prerelease:\n  numbering: dash\n", nil, nil, config.ErrInvalidConfig},
+		{"invalid commit type", ".gosemver.toml", "[commit_types]\nfeat = \"huge\"\n", nil, nil, config.ErrInvalidConfig},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			if err := os.Mkdir(filepath.Join(root, ".git"), 0o700); err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.file != "" {
+				if err := os.WriteFile(filepath.Join(root, tt.file), []byte(tt.content), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			sub := filepath.Join(root, "sub")
+			if err := os.Mkdir(sub, 0o700); err != nil {
+				t.Fatal(err)
+			}
+
+			chdir(t, sub)
+			t.Setenv("GOSEMVER_CONFIG", "")
+
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			got, err := config.LoadProject("")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("LoadProject() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err == nil && !tt.want(got) {
+				t.Errorf("LoadProject() = %+v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package git
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrGit = errors.New("git command failed")
+
+// Repo runs git commands in a working tree. An empty Dir means the current directory.
+type Repo struct {
+	Dir string
+}
+
+func (r Repo) run(args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command("git", args...) //nolint:noctx
+	cmd.Dir = r.Dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("%w: git %s: %s", ErrGit, strings.Join(args, " "), strings.TrimSpace(stderr.String()))
+	}
+
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+func lines(out string) []string {
+	if out == "" {
+		return nil
+	}
+
+	return strings.Split(out, "\n")
+}
+
+// CurrentBranch returns the short name of the checked out branch.
+func (r Repo) CurrentBranch() (string, error) {
+	return r.run("rev-parse", "--abbrev-ref", "HEAD")
+}
+
+// MergedTags lists tags reachable from HEAD.
+func (r Repo) MergedTags() ([]string, error) {
+	out, err := r.run("tag", "--merged", "HEAD")
+	if err != nil {
+		return nil, err
+	}
+
+	return lines(out), nil
+}
+
+// CommitsSince counts commits reachable from HEAD but not from rev. An empty rev counts all
+// commits of HEAD. If paths are given, only commits touching them are counted.
+func (r Repo) CommitsSince(rev string, paths ...string) (int, error) {
+	revRange := "HEAD"
+	if rev != "" {
+		revRange = rev + "..HEAD"
+	}
+
+	args := []string{"rev-list", "--count", revRange}
+	if len(paths) > 0 {
+		args = append(append(args, "--"), paths...)
+	}
+
+	out, err := r.run(args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(out)
+}
+
+// CommitMessages returns the messages of commits reachable from HEAD but not from rev, newest first.
+// An empty rev returns all commits of HEAD. If paths are given, only commits touching them are returned.
+func (r Repo) CommitMessages(rev string, paths ...string) ([]string, error) {
+	revRange := "HEAD"
+	if rev != "" {
+		revRange = rev + "..HEAD"
+	}
+
+	args := []string{"log", "--format=%B%x00", revRange}
+	if len(paths) > 0 {
+		args = append(append(args, "--"), paths...)
+	}
+
+	out, err := r.run(args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages []string
+
+	for _, message := range strings.Split(out, "\x00") {
+		if message = strings.TrimSpace(message); message != "" {
+			messages = append(messages, message)
+		}
+	}
+
+	return messages, nil
+}
+
+// ShortHead returns the abbreviated hash of HEAD.
+func (r Repo) ShortHead() (string, error) {
+	return r.run("rev-parse", "--short", "HEAD")
+}
+
+// IsDirty reports whether the working tree has uncommitted changes or untracked files.
+func (r Repo) IsDirty() (bool, error) {
+	out, err := r.run("status", "--porcelain")
+	if err != nil {
+		return false, err
+	}
+
+	return out != "", nil
+}
+
+// TagsAtHead lists tags pointing at HEAD.
+func (r Repo) TagsAtHead() ([]string, error) {
+	out, err := r.run("tag", "--points-at", "HEAD")
+	if err != nil {
+		return nil, err
+	}
+
+	return lines(out), nil
+}
+
+// CreateAnnotatedTag creates an annotated tag at HEAD.
+func (r Repo) CreateAnnotatedTag(name, message string) error {
+	_, err := r.run("tag", "--annotate", "--message", message, name)
+
+	return err
+}
+
+// ShowFile returns the content of a file at a revision.
+func (r Repo) ShowFile(rev, path string) (string, error) {
+	return r.run("show", rev+":"+path)
+}
+
+// Head returns the full hash and the committer time of HEAD.
+func (r Repo) Head() (string, time.Time, error) {
+	out, err := r.run("show", "--no-patch", "--format=%H %ct", "HEAD")
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	hash, timestamp, _ := strings.Cut(out, " ")
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("%w: unexpected commit time %q", ErrGit, timestamp)
+	}
+
+	return hash, time.Unix(seconds, 0).UTC(), nil
+}
@@ -0,0 +1,123 @@
+package git_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/andreygrechin/gosemver/internal/git"
+)
+
+// newRepo creates a repository with one commit in a temporary directory.
+func newRepo(t *testing.T) git.Repo {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "--quiet", "--initial-branch", "main"},
+		{"config", "user.name", "Test"},
+		{"config", "user.email", "test@example.com"},
+		{"config", "tag.gpgSign", "false"},
+		{"commit", "--quiet", "--allow-empty", "--no-gpg-sign", "--message", "initial"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+	}
+
+	return git.Repo{Dir: dir}
+}
+
+func TestTags(t *testing.T) {
+	repo := newRepo(t)
+
+	if err := repo.CreateAnnotatedTag("v1.2.3", "Release v1.2.3"); err != nil {
+		t.Fatal(err)
+	}
+
+	merged, err := repo.MergedTags()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(merged, []string{"v1.2.3"}) {
+		t.Errorf("MergedTags() = %v, want [v1.2.3]", merged)
+	}
+
+	atHead, err := repo.TagsAtHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(atHead, []string{"v1.2.3"}) {
+		t.Errorf("TagsAtHead() = %v, want [v1.2.3]", atHead)
+	}
+
+	if err := repo.CreateAnnotatedTag("v1.2.3", "Release v1.2.3"); err == nil {
+		t.Error("CreateAnnotatedTag() of an existing tag succeeded")
+	}
+
+	commits, err := repo.CommitsSince("v1.2.3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if commits != 0 {
+		t.Errorf("CommitsSince() = %d, want 0", commits)
+	}
+}
+
+func TestCommitMessages(t *testing.T) {
+	repo := newRepo(t)
+
+	if err := repo.CreateAnnotatedTag("v1.0.0", "Release v1.0.0"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, message := range []string{"fix: a", "feat: b\n\nBREAKING CHANGE: c"} {
+		cmd := exec.Command("git", "commit", "--quiet", "--allow-empty", "--no-gpg-sign", "--message", message)
+		cmd.Dir = repo.Dir
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git commit: %v: %s", err, out)
+		}
+	}
+
+	messages, err := repo.CommitMessages("v1.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"feat: b\n\nBREAKING CHANGE: c", "fix: a"}
+	if !slices.Equal(messages, want) {
+		t.Errorf("CommitMessages() = %q, want %q", messages, want)
+	}
+}
+
+func TestIsDirty(t *testing.T) {
+	repo := newRepo(t)
+
+	dirty, err := repo.IsDirty()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dirty {
+		t.Error("IsDirty() = true for a clean working tree")
+	}
+
+	if err := os.WriteFile(filepath.Join(repo.Dir, "VERSION"), []byte("1.2.3\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	dirty, err = repo.IsDirty()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !dirty {
+		t.Error("IsDirty() = false with an untracked file")
+	}
+}
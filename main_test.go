@@ -16,7 +16,7 @@ func TestExitCodes(t *testing.T) {
 
 		main()
 
-		return
+		os.Exit(0)
 	}
 
 	tests := []struct {
@@ -36,6 +36,8 @@ func TestExitCodes(t *testing.T) {
 		{"invalid version compare", []string{"compare", ""}, 2},
 
 		{"valid version bump", []string{"bump", "major", "1.0.0"}, 0},
+		{"major bump beyond the module path", []string{"bump", "major", "--file", `go.mod:regex:(?m)^go\s(\S+)`}, 1},
+		{"allowed major bump beyond the module path", []string{"bump", "major", "--file", `go.mod:regex:(?m)^go\s(\S+)`, "--allow-major"}, 0},
 		{"invalid version bump", []string{"bump", "major", "not.a.version"}, 1},
 		{"invalid version bump with prerelease flag", []string{"bump", "major", "--prerelease", "beta"}, 2},
 		{"valid version bump with prerelease flag", []string{"bump", "prerelease", "--prerelease", "beta", "1.2.3"}, 0},
@@ -54,6 +56,117 @@ func TestExitCodes(t *testing.T) {
 		{"invalid version diff", []string{"diff", "1.2.3 1.2.4", "-"}, 2},
 		{"invalid version diff", []string{"diff", ""}, 2},
 
+		{"valid module major gomod", []string{"gomod", "check", "1.4.0"}, 0},
+		{"invalid module major gomod", []string{"gomod", "check", "2.0.0", "--fix-hint"}, 1},
+		{"invalid version gomod", []string{"gomod", "check", "1.4"}, 1},
+		{"missing modfile gomod", []string{"gomod", "check", "1.4.0", "--modfile", "missing.mod"}, 2},
+
+		{"invalid version file validate", []string{"validate", "--file", "go.mod"}, 1},
+		{"missing file get", []string{"get", "major", "--file", "missing.json"}, 2},
+		{"file with version get", []string{"get", "major", "--file", "VERSION", "1.2.3"}, 2},
+		{"write without file bump", []string{"bump", "major", "--write", "1.2.3"}, 2},
+		{"set without file", []string{"set", "1.2.3"}, 2},
+
+		{"json output validate", []string{"validate", "--output", "json", "1.0.0"}, 0},
+		{"json output invalid version validate", []string{"validate", "--output", "json", "1.0"}, 1},
+		{"yaml output compare", []string{"compare", "--output", "yaml", "1.0.0", "2.0.0"}, 0},
+		{"template output bump", []string{"bump", "minor", "--template={{.Major}}.{{.Minor}}", "1.0.0"}, 0},
+		{"invalid template bump", []string{"bump", "minor", "--template={{.Nope}}", "1.0.0"}, 2},
+		{"invalid output get", []string{"get", "major", "--output", "xml", "1.0.0"}, 2},
+		{"template output without template get", []string{"get", "major", "--output", "template", "1.0.0"}, 2},
+
+		{"format command", []string{"format", "{{.Major}}.{{.Minor}}.x", "1.2.3"}, 0},
+		{"invalid version format", []string{"format", "{{.Major}}", "1.2"}, 1},
+		{"invalid template format", []string{"format", "{{.Nope}}", "1.2.3"}, 2},
+		{"missing template format", []string{"format", "1.2.3"}, 2},
+
+		{"docker-tags command", []string{"docker-tags", "1.4.2"}, 0},
+		{"invalid version docker-tags", []string{"docker-tags", "1.4"}, 1},
+		{"missing existing docker-tags", []string{"docker-tags", "1.4.2", "--existing", "missing.txt"}, 2},
+		{"both stdin docker-tags", []string{"docker-tags", "-", "--existing", "-"}, 2},
+
+		{"batch validate", []string{"validate", "--batch"}, 0},
+		{"batch bump", []string{"bump", "patch", "--batch"}, 0},
+		{"batch with version validate", []string{"validate", "--batch", "1.2.3"}, 2},
+		{"batch with file get", []string{"get", "major", "--batch", "--file", "VERSION"}, 2},
+
+		{"max command", []string{"max", "1.2.0", "1.10.0"}, 0},
+		{"min command", []string{"min", "1.2.0", "1.10.0", "--stable-only"}, 0},
+		{"latest command", []string{"latest", "1.2.0", "2.0.0-rc.1", "--major", "1"}, 0},
+		{"no match latest", []string{"latest", "1.2.0", "--major", "2"}, 1},
+		{"invalid constraint max", []string{"max", "1.2.0", "--constraint", ">=abc"}, 2},
+		{"missing versions file min", []string{"min", "--versions-file", "missing.txt"}, 2},
+		{"no versions max", []string{"max"}, 2},
+
+		{"filter command", []string{"filter", ">=1.2.0", "1.0.0", "1.4.0"}, 0},
+		{"invert filter", []string{"filter", "--invert", "1.x", "1.0.0", "2.0.0"}, 0},
+		{"no match filter", []string{"filter", ">=3", "1.0.0"}, 1},
+		{"invalid constraint filter", []string{"filter", ">=abc", "1.0.0"}, 2},
+
+		{"next command", []string{"next", "1.2.3"}, 0},
+		{"json output next", []string{"next", "--output", "json", "1.2.3-rc.1"}, 0},
+		{"invalid version next", []string{"next", "1.2"}, 1},
+		{"invalid prerelease next", []string{"next", "1.2.3", "--prerelease", "a..b"}, 2},
+
+		{"satisfies command", []string{"satisfies", ">=1.2.0", "1.4.0"}, 0},
+		{"not satisfies", []string{"satisfies", ">=1.2.0", "1.0.0"}, 1},
+		{"maven satisfies", []string{"satisfies", "--syntax", "maven", "[1.2,2.0)", "1.4.0"}, 0},
+		{"maven not satisfies", []string{"satisfies", "--syntax", "maven", "(,1.0],[1.2,)", "1.1.0"}, 1},
+		{"invalid maven satisfies", []string{"satisfies", "--syntax", "maven", "[1.2,2.0", "1.4.0"}, 2},
+		{"caret satisfies", []string{"satisfies", "^0.2.3", "0.2.9"}, 0},
+		{"caret zero minor not satisfies", []string{"satisfies", "^0.2.3", "0.3.0"}, 1},
+		{"prerelease not satisfies", []string{"satisfies", ">=1.0.0", "1.1.0-rc.1"}, 1},
+		{"cargo satisfies", []string{"satisfies", "--syntax", "cargo", "0.0.3", "0.0.3"}, 0},
+		{"pessimistic satisfies", []string{"satisfies", "--syntax", "gem", "~>2.2", "2.9.0"}, 0},
+		{"npm tilde greater not satisfies", []string{"satisfies", "~>2.2", "2.9.0"}, 1},
+		{"compatible not satisfies", []string{"satisfies", "--syntax", "pip", "~=1.4.5", "1.5.0"}, 1},
+		{"invalid compatible satisfies", []string{"satisfies", "--syntax", "pip", "~=1", "1.5.0"}, 2},
+		{"npm compatible satisfies", []string{"satisfies", "~=1.4.5", "1.4.9"}, 2},
+		{"gem filter", []string{"filter", "--syntax", "gem", "~>2.2", "2.9.1", "3.0.0"}, 0},
+		{"unknown syntax satisfies", []string{"satisfies", "--syntax", "gradle", "1.x", "1.4.0"}, 2},
+		{"invalid version satisfies", []string{"satisfies", "1.x", "1.4"}, 1},
+
+		{"range convert", []string{"range", "convert", "--from", "npm", "--to", "cargo", "^1.2.3"}, 0},
+		{"range convert to go", []string{"range", "convert", "--from", "maven", "--to", "go", "[1.2.3,2.0.0-0)"}, 0},
+		{"prerelease range convert", []string{"range", "convert", "--from", "npm", "--to", "maven", "^1.2.3"}, 2},
+		{"npm to maven range convert", []string{"range", "convert", "--ignore-prereleases", "--to", "maven", "^1.2.3"}, 0},
+		{"maven to npm range convert", []string{"range", "convert", "--ignore-prereleases", "--from", "maven", "--to", "npm", "[1.2,2.0)"}, 0},
+		{"inexpressible range convert", []string{"range", "convert", "--to", "cargo", "1.x||3.x"}, 2},
+		{"unknown syntax range convert", []string{"range", "convert", "--to", "gradle", "1.x"}, 2},
+		{"missing target range convert", []string{"range", "convert", "1.x"}, 2},
+		{"range intersect", []string{"range", "intersect", "^1.2.0", ">=1.4.0"}, 0},
+		{"empty range intersect", []string{"range", "intersect", "^1.2.0", ">=2.0.0"}, 1},
+		{"single range intersect", []string{"range", "intersect", "^1.2.0"}, 2},
+		{"range union", []string{"range", "union", "--syntax", "maven", "[1.0,2.0)", "[2.0,3.0)"}, 0},
+		{"inexpressible range union", []string{"range", "union", "--syntax", "cargo", "1.2", "3.0"}, 2},
+		{"range subset", []string{"range", "subset", "~1.2.3", "^1.0.0"}, 0},
+		{"prerelease range subset", []string{"range", "subset", ">=1.0.0-rc.1", "^1.0.0"}, 1},
+		{"not range subset", []string{"range", "subset", "^1.0.0", "~1.2.3"}, 1},
+		{"range empty", []string{"range", "empty", ">=2.0.0"}, 1},
+		{"empty range empty", []string{"range", "empty", "--syntax", "maven", "(1.0,1.0)"}, 0},
+		{"invalid range empty", []string{"range", "empty", ">=2.x.1"}, 2},
+		{"range simplify", []string{"range", "simplify", ">=1.0.0||1.2.x"}, 0},
+		{"relative range simplify", []string{"range", "simplify", "^1.1.0", "1.0.0", "1.1.0", "1.2.0", "x"}, 0},
+		{"invalid range simplify", []string{"range", "simplify", "1.2.3.4"}, 2},
+		{"missing range simplify", []string{"range", "simplify"}, 2},
+
+		{"missing advisory osv check", []string{"osv", "check", "1.2.0", "missing.json"}, 2},
+		{"invalid version osv check", []string{"osv", "check", "1.2", "missing.json"}, 1},
+		{"missing advisories osv check", []string{"osv", "check", "1.2.0"}, 2},
+
+		{"missing sbom check", []string{"sbom", "check", "missing.json", "--policy", "missing.yaml"}, 2},
+		{"missing policy sbom check", []string{"sbom", "check", "missing.json"}, 2},
+
+		{"convert to pep440", []string{"convert", "--to", "pep440", "1.2.3-rc.1"}, 0},
+		{"convert to semver", []string{"convert", "--to", "semver", "1.2.3rc1"}, 0},
+		{"inconvertible convert", []string{"convert", "--to", "semver", "1.2.3.post2"}, 2},
+		{"invalid version convert", []string{"convert", "--to", "semver", "bogus"}, 1},
+		{"unknown scheme convert", []string{"convert", "--to", "calver", "1.2.3"}, 2},
+		{"missing scheme convert", []string{"convert", "1.2.3"}, 2},
+
+		{"unsupported output", []string{"git", "latest", "--output", "json"}, 2},
+		{"unsupported template", []string{"sync", "check", "--template", "{{.Major}}"}, 2},
+
 		{"help command", []string{"--help"}, 0},
 
 		{"version command", []string{"version"}, 0},
@@ -92,3 +205,45 @@ func TestExitCodes(t *testing.T) {
 		})
 	}
 }
+
+func TestOutputs(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"bump template", []string{"bump", "major", "1.2.3", "--template", "{{.Release}}"}, "2.0.0\n"},
+		{"bump prerelease template", []string{"bump", "prerelease", "1.2.3", "--template", "{{.Release}}-{{.Prerelease}}"}, "1.2.3-1\n"},
+		{"bump text", []string{"bump", "minor", "1.2.3-rc.1"}, "1.3.0\n"},
+		{"range union with prerelease", []string{"range", "union", "1.2.3-rc.1", "1.x"}, ">=1.0.0 <2.0.0 || =1.2.3-rc.1\n"},
+		{"npm to maven range convert", []string{"range", "convert", "--ignore-prereleases", "--to", "maven", "^1.2.3"}, "[1.2.3,2.0.0-0)\n"},
+		{"maven to npm range convert", []string{"range", "convert", "--ignore-prereleases", "--from", "maven", "--to", "npm", "[1.2,2.0)"}, ">=1.2.0 <2.0.0\n"},
+		{"range simplify keeping a prerelease", []string{"range", "simplify", "1.2.3-rc.1||>=1.0.0"}, ">=1.0.0 || =1.2.3-rc.1\n"},
+		{"cargo range simplify", []string{"range", "simplify", "--syntax", "cargo", ">=1.2,<1.5"}, ">=1.2.0, <1.5.0\n"},
+		{"maven range simplify", []string{"range", "simplify", "--syntax", "maven", "[1.0,2.0),[1.5,3.0)"}, "[1.0.0,3.0.0)\n"},
+		{"range intersect with prerelease", []string{"range", "intersect", "^1.2.3-rc.1", "1.2.x"}, ">=1.2.3 <1.3.0\n"},
+	}
+
+	binaryPath, err := os.Executable()
+	if err != nil {
+		t.Fatalf("failed to get executable path: %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := exec.Command(binaryPath, "-test.run=^TestExitCodes$")
+
+			cmd.Env = append(os.Environ(),
+				"BE_CRASHER=1",
+				"TEST_ARGS="+strings.Join(tt.args, " "))
+			out, err := cmd.Output()
+			if err != nil {
+				t.Fatalf("failed to run %v: %v", tt.args, err)
+			}
+
+			if string(out) != tt.want {
+				t.Errorf("got output %q, want %q", out, tt.want)
+			}
+		})
+	}
+}
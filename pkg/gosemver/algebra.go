@@ -0,0 +1,173 @@
+package gosemver
+
+import "fmt"
+
+// Conflict explains why an interval is empty: no version satisfies both comparators.
+type Conflict struct {
+	Lower Comparator
+	Upper Comparator
+}
+
+func (c Conflict) String() string {
+	return fmt.Sprintf("no version is both %s and %s", c.Lower, c.Upper)
+}
+
+// IsEmpty reports whether the set has no versions.
+func (s IntervalSet) IsEmpty() bool {
+	return len(s) == 0
+}
+
+// Intersect returns the versions in both sets.
+func (s IntervalSet) Intersect(other IntervalSet) IntervalSet {
+	var intervals []Interval
+
+	for _, a := range s {
+		for _, b := range other {
+			intervals = append(intervals, a.intersect(b))
+		}
+	}
+
+	return NewIntervalSet(intervals...)
+}
+
+// Union returns the versions in either set.
+func (s IntervalSet) Union(other IntervalSet) IntervalSet {
+	return NewIntervalSet(append(append([]Interval{}, s...), other...)...)
+}
+
+// Complement returns the versions not in the set.
+func (s IntervalSet) Complement() IntervalSet {
+	var (
+		intervals []Interval
+		lower     Bound
+		bounded   bool
+	)
+
+	for _, interval := range s {
+		if interval.Lower.Version != nil {
+			upper := Bound{Version: interval.Lower.Version, Inclusive: !interval.Lower.Inclusive}
+			intervals = append(intervals, Interval{Lower: lower, Upper: upper})
+		}
+
+		if interval.Upper.Version == nil {
+			bounded = true
+
+			break
+		}
+
+		lower = Bound{Version: interval.Upper.Version, Inclusive: !interval.Upper.Inclusive}
+	}
+
+	if !bounded {
+		intervals = append(intervals, Interval{Lower: lower})
+	}
+
+	return NewIntervalSet(intervals...)
+}
+
+// Difference returns the versions in the set but not in other.
+func (s IntervalSet) Difference(other IntervalSet) IntervalSet {
+	return s.Intersect(other.Complement())
+}
+
+// IsSubset reports whether all versions of the set are in other.
+func (s IntervalSet) IsSubset(other IntervalSet) bool {
+	return s.Difference(other).IsEmpty()
+}
+
+// Witness returns a version in the set, preferring the lowest release.
+func (s IntervalSet) Witness() (*SemVer, bool) {
+	for _, interval := range s {
+		var candidate *SemVer
+
+		switch {
+		case interval.Lower.Version == nil:
+			candidate = lowestVersion
+		case interval.Lower.Inclusive:
+			candidate = interval.Lower.Version
+		default:
+			candidate = successor(interval.Lower.Version)
+		}
+
+		if release := newSemVer(candidate.Major, candidate.Minor, candidate.Patch, ""); interval.Contains(release) {
+			return release, true
+		}
+
+		if interval.Contains(candidate) {
+			return candidate, true
+		}
+	}
+
+	return nil, false
+}
+
+// Conflicts explains why no version satisfies the constraint with a conflict for every range, or
+// returns nil if a version may satisfy it.
+func (c *Constraint) Conflicts() []Conflict {
+	conflicts := make([]Conflict, 0, len(c.Ranges))
+
+	for _, r := range c.Ranges {
+		conflict, ok := r.Interval().conflict()
+		if !ok {
+			return nil
+		}
+
+		conflicts = append(conflicts, conflict)
+	}
+
+	return conflicts
+}
+
+// IntersectConflicts explains why no version is in both sets with a conflict for every pair of
+// their intervals by precedence, or returns nil if they overlap by precedence.
+func IntersectConflicts(s, other VersionSet) []Conflict {
+	var conflicts []Conflict
+
+	for _, a := range s.hull() {
+		for _, b := range other.hull() {
+			conflict, ok := a.intersect(b).conflict()
+			if !ok {
+				return nil
+			}
+
+			conflicts = append(conflicts, conflict)
+		}
+	}
+
+	return conflicts
+}
+
+func (i Interval) intersect(other Interval) Interval {
+	return Interval{Lower: maxLower(i.Lower, other.Lower), Upper: minUpper(i.Upper, other.Upper)}
+}
+
+// conflict returns the bounds excluding each other if the interval is empty.
+func (i Interval) conflict() (Conflict, bool) {
+	if !i.IsEmpty() {
+		return Conflict{}, false
+	}
+
+	lower := Comparator{Op: OpGreaterOrEqual, Version: lowestVersion}
+	if i.Lower.Version != nil {
+		lower = Comparator{Op: OpGreater, Version: i.Lower.Version}
+		if i.Lower.Inclusive {
+			lower.Op = OpGreaterOrEqual
+		}
+	}
+
+	upper := Comparator{Op: OpLess, Version: i.Upper.Version}
+	if i.Upper.Inclusive {
+		upper.Op = OpLessOrEqual
+	}
+
+	return Conflict{Lower: lower, Upper: upper}, true
+}
+
+// successor returns the lowest version with a higher precedence.
+func successor(ver *SemVer) *SemVer {
+	if ver.Prerelease != "" {
+		return newSemVer(ver.Major, ver.Minor, ver.Patch, ver.Prerelease+".0")
+	}
+
+	return newSemVer(ver.Major, ver.Minor, ver.Patch+1, "0")
+}
@@ -0,0 +1,179 @@
+package gosemver_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+func mustIntervalSet(t *testing.T, constraint string) gosemver.IntervalSet {
+	t.Helper()
+
+	c, err := gosemver.ParseConstraint(constraint)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c.IntervalSet()
+}
+
+func TestIntervalSetIntersect(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want string
+	}{
+		{"overlapping", "^1.2.0", ">=1.4.0 || ^2.0.0", ">=1.4.0 <2.0.0-0"},
+		{"contained", ">=1.0.0", "~1.2.3", ">=1.2.3 <1.3.0-0"},
+		{"several intervals", "<1.0.0 || >=2.0.0 <3.0.0", ">=0.5.0 <2.5.0", ">=0.5.0 <1.0.0 || >=2.0.0 <2.5.0"},
+		{"touching", ">=1.0.0 <=2.0.0", ">=2.0.0", "=2.0.0"},
+		{"disjoint", "^1.2.0", ">=2.0.0", "<0.0.0-0"},
+		{"exclusive bounds", "<2.0.0", ">2.0.0", "<0.0.0-0"},
+		{"any", "*", "1.2.3", "=1.2.3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mustIntervalSet(t, tt.a).Intersect(mustIntervalSet(t, tt.b)).Constraint().String()
+			if got != tt.want {
+				t.Errorf("Intersect() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntervalSetUnion(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want string
+	}{
+		{"overlapping", "^1.2.0", ">=1.9.0 <2.5.0", ">=1.2.0 <2.5.0"},
+		{"adjacent", ">=1.0.0 <2.0.0", ">=2.0.0 <3.0.0", ">=1.0.0 <3.0.0"},
+		{"disjoint", ">=3.0.0", "<1.0.0", "<1.0.0 || >=3.0.0"},
+		{"with empty", ">2.0.0 <1.0.0", "1.2.3", "=1.2.3"},
+		{"everything", "<2.0.0", ">=1.0.0", "*"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mustIntervalSet(t, tt.a).Union(mustIntervalSet(t, tt.b)).Constraint().String()
+			if got != tt.want {
+				t.Errorf("Union() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntervalSetComplement(t *testing.T) {
+	tests := []struct {
+		name       string
+		constraint string
+		want       string
+	}{
+		{"bounded", ">=1.0.0 <2.0.0", "<1.0.0 || >=2.0.0"},
+		{"lower bound", ">1.0.0", "<=1.0.0"},
+		{"upper bound", "<=1.0.0", ">1.0.0"},
+		{"gaps", "<1.0.0 || =2.0.0 || >3.0.0", ">=1.0.0 <2.0.0 || >2.0.0 <=3.0.0"},
+		{"everything", "*", "<0.0.0-0"},
+		{"nothing", "<0.0.0-0", "*"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mustIntervalSet(t, tt.constraint).Complement().Constraint().String(); got != tt.want {
+				t.Errorf("Complement() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntervalSetIsSubset(t *testing.T) {
+	tests := []struct {
+		name        string
+		a           string
+		b           string
+		want        bool
+		wantWitness string
+	}{
+		{"tilde in caret", "~1.2.3", "^1.0.0", true, ""},
+		{"caret in tilde", "^1.0.0", "~1.2.3", false, "1.0.0"},
+		{"equal", ">=1.0.0 <2.0.0-0", "1.x", true, ""},
+		{"prereleases below upper bound", ">=1.0.0 <2.0.0", "1.x", false, "2.0.0-0"},
+		{"exclusive bound", ">=1.0.0", ">1.0.0", false, "1.0.0"},
+		{"prerelease difference", ">=1.0.0-rc.1 <1.0.0", ">=1.0.0", false, "1.0.0-rc.1"},
+		{"after exclusive bound", "<1.0.0 || >2.0.0", "<1.0.0 || >=3.0.0", false, "2.0.1"},
+		{"empty", ">2.0.0 <1.0.0", "1.2.3", true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := mustIntervalSet(t, tt.a), mustIntervalSet(t, tt.b)
+			if got := a.IsSubset(b); got != tt.want {
+				t.Errorf("IsSubset() = %v, want %v", got, tt.want)
+			}
+
+			witness, ok := a.Difference(b).Witness()
+			if ok != (tt.wantWitness != "") || (ok && witness.String() != tt.wantWitness) {
+				t.Errorf("Witness() = %v, %v, want %q", witness, ok, tt.wantWitness)
+			}
+		})
+	}
+}
+
+func TestConstraintConflicts(t *testing.T) {
+	tests := []struct {
+		name       string
+		constraint string
+		want       []string
+	}{
+		{"crossed bounds", ">=2.0.0 <1.5.0", []string{"no version is both >=2.0.0 and <1.5.0"}},
+		{
+			"every alternative",
+			">2.0.0 <1.0.0 || >3.0.0 <=3.0.0",
+			[]string{"no version is both >2.0.0 and <1.0.0", "no version is both >3.0.0 and <=3.0.0"},
+		},
+		{"below lowest version", "<0.0.0-0", []string{"no version is both >=0.0.0-0 and <0.0.0-0"}},
+		{"satisfiable alternative", ">2.0.0 <1.0.0 || 1.2.3", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := gosemver.ParseConstraint(tt.constraint)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, conflict := range c.Conflicts() {
+				got = append(got, conflict.String())
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Conflicts() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntersectConflicts(t *testing.T) {
+	a := mustVersionSet(t, "<1.0.0 || >=3.0.0")
+	b := mustVersionSet(t, ">=1.5.0 <2.0.0")
+
+	var got []string
+	for _, conflict := range gosemver.IntersectConflicts(a, b) {
+		got = append(got, conflict.String())
+	}
+
+	want := []string{"no version is both >=1.5.0 and <1.0.0", "no version is both >=3.0.0 and <2.0.0"}
+	if !slices.Equal(got, want) {
+		t.Errorf("IntersectConflicts() = %q, want %q", got, want)
+	}
+
+	if got := gosemver.IntersectConflicts(a, mustVersionSet(t, "^3.1.0")); got != nil {
+		t.Errorf("IntersectConflicts() of overlapping sets = %v, want nil", got)
+	}
+}
@@ -0,0 +1,127 @@
+package gosemver
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidBranchRule = errors.New("invalid branch rule")
+	ErrEmptyBranch       = errors.New("branch name is empty")
+
+	invalidPrereleaseChars = regexp.MustCompile(`[^0-9A-Za-z-]+`)
+	repeatedHyphens        = regexp.MustCompile(`-{2,}`)
+)
+
+// BranchRule maps branches matching Pattern to a prerelease Label. The label may reference
+// capture groups of the pattern ($1, ${name}). An empty label marks the branch as stable.
+type BranchRule struct {
+	Pattern *regexp.Regexp
+	Label   string
+}
+
+// DefaultBranchRules follows the GitVersion branch model.
+var DefaultBranchRules = []BranchRule{
+	{Pattern: regexp.MustCompile(`^(?:main|master)$`), Label: ""},
+	{Pattern: regexp.MustCompile(`^develop$`), Label: "alpha"},
+	{Pattern: regexp.MustCompile(`^(?:release|hotfix)/.+$`), Label: "rc"},
+	{Pattern: regexp.MustCompile(`^(?:feature|bugfix)/(.+)$`), Label: "$1"},
+}
+
+// ParseBranchRule parses a rule in the "<pattern>=<label>" form, e.g. "^develop$=alpha".
+// The last '=' separates the pattern from the label.
+func ParseBranchRule(rule string) (BranchRule, error) {
+	idx := strings.LastIndex(rule, "=")
+	if idx <= 0 {
+		return BranchRule{}, fmt.Errorf("%w: %s", ErrInvalidBranchRule, rule)
+	}
+
+	pattern, err := regexp.Compile(rule[:idx])
+	if err != nil {
+		return BranchRule{}, fmt.Errorf("%w: %w", ErrInvalidBranchRule, err)
+	}
+
+	return BranchRule{Pattern: pattern, Label: rule[idx+1:]}, nil
+}
+
+// SanitizePrerelease converts an arbitrary string into a single prerelease identifier
+// that passes IsPrerelease. Unsupported characters are replaced with hyphens.
+func SanitizePrerelease(id string) string {
+	s := invalidPrereleaseChars.ReplaceAllString(id, "-")
+	s = repeatedHyphens.ReplaceAllString(s, "-")
+	s = strings.Trim(s, "-")
+
+	// numeric identifiers must not include leading zeroes
+	if _, err := strconv.Atoi(s); err == nil {
+		s = strings.TrimLeft(s, "0")
+		if s == "" {
+			s = "0"
+		}
+	}
+
+	return s
+}
+
+// BranchPrerelease returns the prerelease label for a branch according to the first matching
+// rule. Branches without a matching rule use their sanitized name. The second return value
+// reports whether the branch is stable, i.e. its versions have no prerelease.
+func BranchPrerelease(branch string, rules []BranchRule) (string, bool, error) {
+	if branch == "" {
+		return "", false, ErrEmptyBranch
+	}
+
+	label := branch
+
+	for _, rule := range rules {
+		match := rule.Pattern.FindStringSubmatchIndex(branch)
+		if match == nil {
+			continue
+		}
+
+		if rule.Label == "" {
+			return "", true, nil
+		}
+
+		label = string(rule.Pattern.ExpandString(nil, rule.Label, branch, match))
+
+		break
+	}
+
+	label = SanitizePrerelease(label)
+	if label == "" {
+		return "", false, fmt.Errorf("%w: cannot derive a prerelease id from branch %s", ErrInvalidPrerelease, branch)
+	}
+
+	return label, false, nil
+}
+
+// BranchVersion derives the version of a commit on a branch from the latest released version.
+// The result for a stable branch without new commits equals base. Otherwise the patch of a release
+// is bumped; prerelease branches get "<label>.<commits>" as prerelease, stable ones get the commit
+// counter as build metadata. The prerelease of a prerelease base is kept as a prefix, so that
+// '1.2.0-rc.1' becomes '1.2.0-rc.1.foo-bar.5', which sorts above the base.
+func BranchVersion(base *SemVer, label string, stable bool, commits int) *SemVer {
+	ver := *base
+	ver.Build = ""
+
+	if commits == 0 && (stable || ver.Prerelease != "") {
+		return &ver
+	}
+
+	if ver.Prerelease == "" {
+		ver.Patch++
+		ver.Release = fmt.Sprintf("%d.%d.%d", ver.Major, ver.Minor, ver.Patch)
+	}
+
+	if stable {
+		ver.Prerelease = ""
+		ver.Build = strconv.Itoa(commits)
+	} else {
+		ver.Prerelease = strings.TrimPrefix(fmt.Sprintf("%s.%s.%d", ver.Prerelease, label, commits), ".")
+	}
+
+	return &ver
+}
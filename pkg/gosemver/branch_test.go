@@ -0,0 +1,146 @@
+package gosemver_test
+
+import (
+	"testing"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+func TestSanitizePrerelease(t *testing.T) {
+	tests := []struct {
+		name string
+		id   string
+		want string
+	}{
+		{"already valid", "foo-bar", "foo-bar"},
+		{"slashes", "feature/foo-bar", "feature-foo-bar"},
+		{"dots and underscores", "JIRA_123.fix", "JIRA-123-fix"},
+		{"repeated separators", "foo//__bar--baz", "foo-bar-baz"},
+		{"leading and trailing", "-_foo_-", "foo"},
+		{"numeric with leading zeroes", "007", "7"},
+		{"zero", "000", "0"},
+		{"unicode", "fix-ümlaut", "fix-mlaut"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := gosemver.SanitizePrerelease(tt.id)
+			if got != tt.want {
+				t.Errorf("SanitizePrerelease(%q) = %q, want %q", tt.id, got, tt.want)
+			}
+
+			if !gosemver.IsPrerelease(got) {
+				t.Errorf("SanitizePrerelease(%q) = %q is not a valid prerelease", tt.id, got)
+			}
+		})
+	}
+}
+
+func TestBranchPrerelease(t *testing.T) {
+	custom, err := gosemver.ParseBranchRule(`^support/(\d+)\.x$=support$1`)
+	if err != nil {
+		t.Fatalf("ParseBranchRule() error = %v", err)
+	}
+
+	rules := append([]gosemver.BranchRule{custom}, gosemver.DefaultBranchRules...)
+
+	tests := []struct {
+		name       string
+		branch     string
+		wantLabel  string
+		wantStable bool
+		wantErr    bool
+	}{
+		{"main", "main", "", true, false},
+		{"master", "master", "", true, false},
+		{"develop", "develop", "alpha", false, false},
+		{"release", "release/1.3", "rc", false, false},
+		{"feature", "feature/foo-bar", "foo-bar", false, false},
+		{"nested feature", "feature/JIRA-1/foo_bar", "JIRA-1-foo-bar", false, false},
+		{"custom rule", "support/2.x", "support2", false, false},
+		{"unmatched", "renovate/cobra-1.x", "renovate-cobra-1-x", false, false},
+		{"empty", "", "", false, true},
+		{"nothing left", "___", "", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			label, stable, err := gosemver.BranchPrerelease(tt.branch, rules)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BranchPrerelease() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if label != tt.wantLabel || stable != tt.wantStable {
+				t.Errorf("BranchPrerelease() = %q, %v, want %q, %v", label, stable, tt.wantLabel, tt.wantStable)
+			}
+		})
+	}
+}
+
+func TestParseBranchRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		wantErr bool
+	}{
+		{"label", "^develop$=alpha", false},
+		{"stable", "^trunk$=", false},
+		{"equal sign in pattern", "^a=b$=c", false},
+		{"no separator", "^develop$", true},
+		{"no pattern", "=alpha", true},
+		{"invalid pattern", "^(develop$=alpha", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := gosemver.ParseBranchRule(tt.rule)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseBranchRule(%q) error = %v, wantErr %v", tt.rule, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBranchVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		base    string
+		label   string
+		stable  bool
+		commits int
+		want    string
+	}{
+		{"stable on tag", "1.2.3", "", true, 0, "1.2.3"},
+		{"stable ahead", "1.2.3", "", true, 4, "1.2.4+4"},
+		{"prerelease branch", "1.2.3", "foo-bar", false, 5, "1.2.4-foo-bar.5"},
+		{"prerelease branch on tag", "1.2.3", "alpha", false, 0, "1.2.4-alpha.0"},
+		{"prerelease base", "1.3.0-rc.1", "rc", false, 2, "1.3.0-rc.1.rc.2"},
+		{"prerelease base on another branch", "1.2.0-rc.1", "foo-bar", false, 3, "1.2.0-rc.1.foo-bar.3"},
+		{"prerelease base above commit count", "1.2.0-rc.5", "rc", false, 2, "1.2.0-rc.5.rc.2"},
+		{"stable ahead of prerelease base", "1.2.0-rc.1", "", true, 2, "1.2.0+2"},
+		{"prerelease base on tag", "1.3.0-rc.1", "rc", false, 0, "1.3.0-rc.1"},
+		{"build metadata dropped", "1.2.3+abc", "", true, 0, "1.2.3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			base, err := gosemver.ParseSemVer(tt.base)
+			if err != nil {
+				t.Fatalf("ParseSemVer() error = %v", err)
+			}
+
+			got := gosemver.BranchVersion(base, tt.label, tt.stable, tt.commits)
+			if got.String() != tt.want {
+				t.Errorf("BranchVersion() = %s, want %s", got, tt.want)
+			}
+
+			if cmp, _ := gosemver.CompareSemVer(got.String(), tt.base); tt.commits > 0 && !tt.stable && cmp <= 0 {
+				t.Errorf("BranchVersion() = %s does not sort above %s", got, tt.base)
+			}
+
+			if base.String() != tt.base {
+				t.Errorf("BranchVersion() modified base to %s", base)
+			}
+		})
+	}
+}
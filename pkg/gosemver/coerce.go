@@ -0,0 +1,67 @@
+package gosemver
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// coerceRegexp matches versions with one to three numbers, optionally followed by a qualifier after a
+// '-', or after a '.' if it starts with a letter, and build metadata.
+var coerceRegexp = regexp.MustCompile(
+	`^[vV]?(\d+)(?:\.(\d+))?(?:\.(\d+))?(?:-([0-9A-Za-z.-]+)|\.([A-Za-z][0-9A-Za-z.-]*))?(?:\+([0-9A-Za-z.-]+))?$`,
+)
+
+// releaseQualifiers mark release versions in ecosystems like Maven, e.g. '1.2.3.Final'.
+var releaseQualifiers = []string{"final", "release", "ga"}
+
+// CoerceSemVer converts a version loosely following semantic versioning, like 'v1.2', '1.0-SNAPSHOT'
+// or '2.5.3.Final', to a semantic version. Missing minor and patch numbers are zero, leading zeros
+// are dropped, a qualifier becomes the prerelease unless it marks a release like 'Final', 'RELEASE'
+// or 'GA', and a qualifier separated by a '.' must start with a letter.
+func CoerceSemVer(version string) (*SemVer, error) {
+	version = strings.TrimSpace(version)
+	if ver, err := ParseSemVer(version); err == nil {
+		return ver, nil
+	}
+
+	matches := coerceRegexp.FindStringSubmatch(version)
+	if matches == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidVersion, version)
+	}
+
+	var parts [3]int
+
+	for i, field := range matches[1:4] {
+		if field == "" {
+			continue
+		}
+
+		n, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidVersion, version)
+		}
+		parts[i] = n
+	}
+
+	qualifier := matches[4] + matches[5]
+	for _, release := range releaseQualifiers {
+		if strings.EqualFold(qualifier, release) {
+			qualifier = ""
+		}
+	}
+
+	if qualifier != "" && !IsPrerelease(qualifier) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidVersion, version)
+	}
+
+	ver := newSemVer(parts[0], parts[1], parts[2], qualifier)
+	ver.Build = matches[6]
+
+	if ver.Build != "" && !IsBuild(ver.Build) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidVersion, version)
+	}
+
+	return ver, nil
+}
@@ -0,0 +1,47 @@
+package gosemver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+func TestCoerceSemVer(t *testing.T) {
+	tests := []struct {
+		version string
+		want    string
+		wantErr error
+	}{
+		{"1.2.3", "1.2.3", nil},
+		{"v1.2.3-rc.1+build.5", "1.2.3-rc.1+build.5", nil},
+		{" 1.2.3\n", "1.2.3", nil},
+		{"v1", "1.0.0", nil},
+		{"1.2", "1.2.0", nil},
+		{"01.02.003", "1.2.3", nil},
+		{"1.0-SNAPSHOT", "1.0.0-SNAPSHOT", nil},
+		{"3.0.7-r0", "3.0.7-r0", nil},
+		{"2.5.3.Final", "2.5.3", nil},
+		{"5.3.RELEASE", "5.3.0", nil},
+		{"1.2.3.beta1", "1.2.3-beta1", nil},
+		{"1.2+build", "1.2.0+build", nil},
+		{"1.2.3.4", "", gosemver.ErrInvalidVersion},
+		{"1:2.3.4-1", "", gosemver.ErrInvalidVersion},
+		{"1.2.3-01", "", gosemver.ErrInvalidVersion},
+		{"latest", "", gosemver.ErrInvalidVersion},
+		{"", "", gosemver.ErrInvalidVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			got, err := gosemver.CoerceSemVer(tt.version)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CoerceSemVer() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err == nil && got.String() != tt.want {
+				t.Errorf("CoerceSemVer() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
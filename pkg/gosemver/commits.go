@@ -0,0 +1,50 @@
+package gosemver
+
+import (
+	"regexp"
+	"strings"
+)
+
+// conventionalHeader matches the header of a Conventional Commits message, 'type(scope)!: description'.
+var conventionalHeader = regexp.MustCompile(`^([A-Za-z][\w-]*)(?:\([^()]*\))?(!)?: \S`)
+
+// CommitBump returns the identifier (major, minor or patch) a list of commit messages bumps, following
+// Conventional Commits: a '!' after the type or a 'BREAKING CHANGE' footer bumps the major version and
+// other commits bump what types maps their type to. Types mapped to "none", types missing from types
+// and messages not following Conventional Commits bump nothing. The highest bump wins, an empty string
+// means that no commit bumps the version.
+func CommitBump(messages []string, types map[string]string) string {
+	ranks := map[string]int{Patch: 1, Minor: 2, Major: 3} //nolint:mnd
+	bump := ""
+
+	for _, message := range messages {
+		header, body, _ := strings.Cut(strings.TrimSpace(message), "\n")
+
+		match := conventionalHeader.FindStringSubmatch(header)
+		if match == nil {
+			continue
+		}
+
+		semverID := types[strings.ToLower(match[1])]
+		if match[2] != "" || hasBreakingFooter(body) {
+			semverID = Major
+		}
+
+		if ranks[semverID] > ranks[bump] {
+			bump = semverID
+		}
+	}
+
+	return bump
+}
+
+// hasBreakingFooter reports whether a commit message body has a 'BREAKING CHANGE' footer.
+func hasBreakingFooter(body string) bool {
+	for _, line := range strings.Split(body, "\n") {
+		if strings.HasPrefix(line, "BREAKING CHANGE:") || strings.HasPrefix(line, "BREAKING-CHANGE:") {
+			return true
+		}
+	}
+
+	return false
+}
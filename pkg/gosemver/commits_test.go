@@ -0,0 +1,37 @@
+package gosemver_test
+
+import (
+	"testing"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+func TestCommitBump(t *testing.T) {
+	types := map[string]string{"feat": "minor", "fix": "patch", "docs": "none"}
+
+	tests := []struct {
+		name     string
+		messages []string
+		want     string
+	}{
+		{"no commits", nil, ""},
+		{"fix", []string{"fix: handle empty input"}, gosemver.Patch},
+		{"feature wins over fix", []string{"fix: a", "feat(cli): b", "fix: c"}, gosemver.Minor},
+		{"breaking marker", []string{"feat: a", "refactor(api)!: drop v1"}, gosemver.Major},
+		{"breaking footer", []string{"fix: a\n\nBREAKING CHANGE: removes the flag"}, gosemver.Major},
+		{"breaking footer with hyphen", []string{"fix: a\n\nBREAKING-CHANGE: removes the flag"}, gosemver.Major},
+		{"type mapped to none", []string{"docs: update README"}, ""},
+		{"unmapped type", []string{"chore: bump deps"}, ""},
+		{"case insensitive type", []string{"Feat: a"}, gosemver.Minor},
+		{"not conventional", []string{"Merge branch 'main'", "fix typo"}, ""},
+		{"breaking footer of other commit", []string{"Merge\n\nBREAKING CHANGE: x"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gosemver.CommitBump(tt.messages, types); got != tt.want {
+				t.Errorf("CommitBump(%q) = %q, want %q", tt.messages, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,566 @@
+package gosemver
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidConstraint = errors.New("invalid version constraint")
+	ErrUnknownSyntax     = errors.New("unknown constraint syntax")
+)
+
+// Constraint syntaxes.
+const (
+	SyntaxNative = "native"
+	SyntaxNpm    = "npm"
+	SyntaxCargo  = "cargo"
+	SyntaxMaven  = "maven"
+	SyntaxGem    = "gem"
+	SyntaxPip    = "pip"
+)
+
+// Comparison operators of a Comparator.
+const (
+	OpEqual          = "="
+	OpGreater        = ">"
+	OpGreaterOrEqual = ">="
+	OpLess           = "<"
+	OpLessOrEqual    = "<="
+	// OpCaret allows changes that do not modify the left-most non-zero part: '^1.2.3' means
+	// '>=1.2.3 <2.0.0-0', '^0.2.3' means '>=0.2.3 <0.3.0-0' and '^0.0.3' means '>=0.0.3 <0.0.4-0'.
+	OpCaret = "^"
+	// OpTilde allows patch changes if a minor version is given and minor changes otherwise:
+	// '~1.2.3' means '>=1.2.3 <1.3.0-0' and '~1' means '>=1.0.0 <2.0.0-0'.
+	OpTilde = "~"
+	// OpPessimistic is the RubyGems pessimistic operator of the gem syntax, allowing the last given part
+	// to increase: '~>2.2' means '>=2.2.0 <3.0.0-0', '~>2.2.0' means '>=2.2.0 <2.3.0-0' and '~>2' means
+	// '>=2.0.0 <3.0.0-0'. In the npm syntax '~>' is the same as '~'.
+	OpPessimistic = "~>"
+	// OpCompatible is the Python compatible release operator of the pip syntax, like OpPessimistic but
+	// requiring at least a major and a minor version: '~=1.4.5' means '>=1.4.5 <1.5.0-0'.
+	OpCompatible = "~="
+	// opPipEqual is the equality operator of the pip syntax.
+	opPipEqual = "=="
+)
+
+// dialect describes how a constraint syntax writes comparators.
+type dialect struct {
+	// operators are the operators of the syntax, each listed before the operators it starts with.
+	operators []string
+	// aliases maps operators of the syntax to the operator they stand for.
+	aliases map[string]string
+	// bareOp is the operator of a version given without one.
+	bareOp string
+	// exact makes partial versions exact, '>1.2' means '>1.2.0', except for the pessimistic operators
+	// and wildcards.
+	exact bool
+	// npmRanges allows '||' between ranges and hyphen ranges.
+	npmRanges bool
+}
+
+var (
+	npmDialect = dialect{
+		operators: []string{
+			OpGreaterOrEqual, OpLessOrEqual, OpPessimistic, OpGreater, OpLess, OpEqual, OpCaret, OpTilde,
+		},
+		aliases:   map[string]string{OpPessimistic: OpTilde},
+		npmRanges: true,
+	}
+	cargoDialect = dialect{
+		operators: []string{OpGreaterOrEqual, OpLessOrEqual, OpGreater, OpLess, OpEqual, OpCaret, OpTilde},
+		bareOp:    OpCaret,
+		npmRanges: true,
+	}
+	gemDialect = dialect{
+		operators: []string{OpGreaterOrEqual, OpLessOrEqual, OpPessimistic, OpGreater, OpLess, OpEqual},
+		bareOp:    OpEqual,
+		exact:     true,
+	}
+	pipDialect = dialect{
+		operators: []string{OpCompatible, opPipEqual, OpGreaterOrEqual, OpLessOrEqual, OpGreater, OpLess},
+		aliases:   map[string]string{opPipEqual: OpEqual},
+		exact:     true,
+	}
+)
+
+// Constraint is a set of version ranges, a version satisfies it if it is in any of the ranges.
+//
+// The syntax follows npm: ranges are separated by '||', the comparators of a range by spaces or commas.
+// A comparator is an operator (=, >, >=, <, <=, ^, ~) followed by a version, which may be partial or
+// have wildcards: '1.2', '1.2.x' and '=1.2' mean '>=1.2.0 <1.3.0-0', '>1.2' means '>=1.3.0', '<=1'
+// means '<2.0.0-0', '*' matches any version. A hyphen range 'A - B' means '>=A <=B'. As in npm, '~>'
+// is the same as '~'.
+//
+// As in npm and Cargo, a prerelease only satisfies a range if one of its comparators has a prerelease
+// of the same major, minor and patch version, unless IncludePrerelease is set: '>=1.2.3-beta.1'
+// matches '1.2.3-beta.2' but not '1.2.4-beta.1', and '*' matches no prerelease.
+type Constraint struct {
+	Ranges []Range
+	// IncludePrerelease makes prereleases satisfy ranges by precedence only.
+	IncludePrerelease bool
+}
+
+// Range is a set of comparators which must all be satisfied, an empty range matches any version.
+type Range []Comparator
+
+// Comparator compares a version against a fixed version.
+type Comparator struct {
+	Op      string
+	Version *SemVer
+}
+
+// partialVersion is a version which may lack minor and patch numbers or have wildcards instead.
+type partialVersion struct {
+	ver *SemVer
+	// parts is the number of numeric parts given, 3 for a full version.
+	parts int
+}
+
+// ParseConstraint parses a constraint expression.
+func ParseConstraint(constraint string) (*Constraint, error) {
+	return parseConstraint(constraint, npmDialect)
+}
+
+// ParseCargoRequirement parses a Cargo version requirement like '1.2, <1.5' where a version without
+// an operator is a caret requirement. Alternatives with '||' are not allowed.
+func ParseCargoRequirement(constraint string) (*Constraint, error) {
+	if strings.Contains(constraint, "||") {
+		return nil, fmt.Errorf("%w: %q: Cargo requirements cannot have alternatives", ErrInvalidConstraint, constraint)
+	}
+
+	return parseConstraint(constraint, cargoDialect)
+}
+
+// ParseGemRequirement parses a RubyGems requirement like '~> 2.2, >= 2.2.1' with the operators =, >, >=,
+// <, <= and ~>, where a version without an operator must match exactly. Partial versions are padded
+// with zeros, '> 2.2' means '>2.2.0', only '~>' uses the number of parts given.
+func ParseGemRequirement(constraint string) (*Constraint, error) {
+	return parseConstraint(constraint, gemDialect)
+}
+
+// ParsePipSpecifier parses a Python version specifier like '~=1.4.5, <1.4.9' restricted to the
+// operators ==, >, >=, <, <= and ~=. Partial versions are padded with zeros, '>1.2' means '>1.2.0',
+// only '~=' uses the number of parts given and '==1.4.*' matches the 1.4 versions. Versions are
+// semantic versions, not PEP 440 versions.
+func ParsePipSpecifier(constraint string) (*Constraint, error) {
+	return parseConstraint(constraint, pipDialect)
+}
+
+// ParseConstraintSyntax parses a constraint written in the given syntax.
+func ParseConstraintSyntax(constraint, syntax string) (*Constraint, error) {
+	switch syntax {
+	case SyntaxNative, SyntaxNpm, "":
+		return ParseConstraint(constraint)
+	case SyntaxCargo:
+		return ParseCargoRequirement(constraint)
+	case SyntaxMaven:
+		return ParseMavenRange(constraint)
+	case SyntaxGem:
+		return ParseGemRequirement(constraint)
+	case SyntaxPip:
+		return ParsePipSpecifier(constraint)
+	case SyntaxGo:
+		return ParseGoRequirement(constraint)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSyntax, syntax)
+	}
+}
+
+// parseConstraint parses a constraint written in a dialect.
+func parseConstraint(constraint string, d dialect) (*Constraint, error) {
+	if !d.npmRanges && strings.Contains(constraint, "||") {
+		return nil, fmt.Errorf("%w: %q: alternatives with '||' are not allowed", ErrInvalidConstraint, constraint)
+	}
+
+	var c Constraint
+
+	for _, expr := range strings.Split(constraint, "||") {
+		r, err := parseRange(expr, d)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %w", ErrInvalidConstraint, constraint, err)
+		}
+
+		c.Ranges = append(c.Ranges, r)
+	}
+
+	return &c, nil
+}
+
+// Check reports whether a version satisfies the constraint.
+func (c *Constraint) Check(ver *SemVer) bool {
+	for _, r := range c.Ranges {
+		if r.Check(ver) && (c.IncludePrerelease || ver.Prerelease == "" || r.allowsPrerelease(ver)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String returns the constraint with every range expanded to plain comparators.
+func (c *Constraint) String() string {
+	if len(c.Ranges) == 0 {
+		return OpLess + lowestVersion.String()
+	}
+
+	ranges := make([]string, 0, len(c.Ranges))
+	for _, r := range c.Ranges {
+		ranges = append(ranges, r.String())
+	}
+
+	return strings.Join(ranges, " || ")
+}
+
+// Check reports whether a version satisfies all comparators of the range.
+func (r Range) Check(ver *SemVer) bool {
+	for _, comparator := range r {
+		if !comparator.Check(ver) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// allowsPrerelease reports whether a comparator has a prerelease of the same version as ver.
+func (r Range) allowsPrerelease(ver *SemVer) bool {
+	for _, comparator := range r {
+		if comparator.Version.Prerelease != "" && comparator.Version.Major == ver.Major &&
+			comparator.Version.Minor == ver.Minor && comparator.Version.Patch == ver.Patch {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r Range) String() string {
+	if len(r) == 0 {
+		return "*"
+	}
+
+	comparators := make([]string, 0, len(r))
+	for _, comparator := range r {
+		comparators = append(comparators, comparator.String())
+	}
+
+	return strings.Join(comparators, " ")
+}
+
+// Check reports whether a version satisfies the comparator by precedence, build metadata is ignored.
+func (c Comparator) Check(ver *SemVer) bool {
+	cmp := compareVersions(ver, c.Version)
+
+	switch c.Op {
+	case OpEqual:
+		return cmp == 0
+	case OpGreater:
+		return cmp > 0
+	case OpGreaterOrEqual:
+		return cmp >= 0
+	case OpLess:
+		return cmp < 0
+	case OpLessOrEqual:
+		return cmp <= 0
+	}
+
+	return false
+}
+
+func (c Comparator) String() string {
+	return c.Op + c.Version.String()
+}
+
+// Satisfies reports whether a version satisfies a constraint expression.
+func Satisfies(version, constraint string) (bool, error) {
+	ver, err := ParseSemVer(version)
+	if err != nil {
+		return false, err
+	}
+
+	c, err := ParseConstraint(constraint)
+	if err != nil {
+		return false, err
+	}
+
+	return c.Check(ver), nil
+}
+
+func parseRange(expr string, d dialect) (Range, error) {
+	tokens := strings.FieldsFunc(expr, func(r rune) bool { return r == ' ' || r == '\t' || r == ',' })
+
+	r := Range{}
+
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+
+		if d.npmRanges && i+2 < len(tokens) && tokens[i+1] == "-" {
+			comparators, err := hyphenRange(token, tokens[i+2])
+			if err != nil {
+				return nil, err
+			}
+
+			r = append(r, comparators...)
+			i += 2
+
+			continue
+		}
+
+		op, version := splitOperator(token, d.operators)
+		if alias, ok := d.aliases[op]; ok {
+			op = alias
+		}
+		if op == "" {
+			if d.bareOp == "" && d.exact {
+				return nil, fmt.Errorf("version %q without an operator", version)
+			}
+
+			op = d.bareOp
+		}
+		if version == "" {
+			if i+1 == len(tokens) {
+				return nil, fmt.Errorf("operator %q without a version", op)
+			}
+
+			i++
+			version = tokens[i]
+		}
+
+		comparators, err := expandComparator(op, version, d.exact)
+		if err != nil {
+			return nil, err
+		}
+
+		r = append(r, comparators...)
+	}
+
+	return r, nil
+}
+
+// splitOperator splits a leading comparison operator from a token.
+func splitOperator(token string, operators []string) (string, string) {
+	for _, op := range operators {
+		if version, ok := strings.CutPrefix(token, op); ok {
+			return op, version
+		}
+	}
+
+	return "", token
+}
+
+// hyphenRange expands 'from - to' to comparators, partial bounds are inclusive of the whole range.
+func hyphenRange(from, to string) (Range, error) {
+	lower, err := parsePartialVersion(from)
+	if err != nil {
+		return nil, err
+	}
+
+	upper, err := parsePartialVersion(to)
+	if err != nil {
+		return nil, err
+	}
+
+	r := append(Range{}, lower.comparators(OpGreaterOrEqual)...)
+
+	return append(r, upper.comparators(OpLessOrEqual)...), nil
+}
+
+// expandComparator expands a comparator to comparators on full versions, exact pads partial versions
+// with zeros unless they end with a wildcard or op is a pessimistic operator.
+func expandComparator(op, version string, exact bool) (Range, error) {
+	p, err := parsePartialVersion(version)
+	if err != nil {
+		return nil, err
+	}
+
+	if exact && op != OpPessimistic && op != OpCompatible && !strings.HasSuffix(version, "*") {
+		p.parts = 3
+	}
+
+	if op == OpCompatible && p.parts < 2 { //nolint:mnd
+		return nil, fmt.Errorf("'~=' requires at least a major and a minor version, got %q", version)
+	}
+
+	if (op == OpPessimistic || op == OpCompatible) && p.parts == 0 {
+		return nil, fmt.Errorf("%q requires a version, got %q", op, version)
+	}
+
+	return p.comparators(op), nil
+}
+
+// parsePartialVersion parses a full version or one of '*', 'x', '1', '1.x', '1.2', '1.2.*' and so on.
+func parsePartialVersion(version string) (partialVersion, error) {
+	if ver, err := ParseSemVer(version); err == nil {
+		return partialVersion{ver: ver, parts: 3}, nil //nolint:mnd
+	}
+
+	trimmed := strings.TrimLeft(version, "vV")
+	trimmed, _, _ = strings.Cut(trimmed, "+")
+
+	fields := strings.Split(trimmed, ".")
+	if len(fields) > 3 { //nolint:mnd
+		return partialVersion{}, fmt.Errorf("%w: %s", ErrInvalidVersion, version)
+	}
+
+	var (
+		numbers [3]int
+		parts   int
+	)
+
+	for i, field := range fields {
+		if field == "*" || field == "x" || field == "X" {
+			break
+		}
+
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 0 || (len(field) > 1 && field[0] == '0') {
+			return partialVersion{}, fmt.Errorf("%w: %s", ErrInvalidVersion, version)
+		}
+
+		numbers[i] = n
+		parts++
+	}
+
+	for _, field := range fields[parts:] {
+		if field != "*" && field != "x" && field != "X" {
+			return partialVersion{}, fmt.Errorf("%w: %s", ErrInvalidVersion, version)
+		}
+	}
+
+	return partialVersion{ver: newSemVer(numbers[0], numbers[1], numbers[2], ""), parts: parts}, nil
+}
+
+// comparators expands an operator applied to a partial version into comparators on full versions.
+func (p partialVersion) comparators(op string) Range {
+	switch op {
+	case OpCaret:
+		return p.caret()
+	case OpTilde:
+		return p.tilde()
+	case OpPessimistic, OpCompatible:
+		return p.pessimistic()
+	}
+
+	if p.parts == 3 { //nolint:mnd
+		if op == "" {
+			op = OpEqual
+		}
+
+		return Range{{Op: op, Version: p.ver}}
+	}
+
+	if p.parts == 0 {
+		switch op {
+		case OpGreater, OpLess:
+			return Range{{Op: OpLess, Version: lowestVersion}}
+		default:
+			return Range{}
+		}
+	}
+
+	lower := p.ver
+	upper := newSemVer(p.ver.Major+1, 0, 0, "")
+	if p.parts == 2 { //nolint:mnd
+		upper = newSemVer(p.ver.Major, p.ver.Minor+1, 0, "")
+	}
+
+	switch op {
+	case OpGreater:
+		return Range{{Op: OpGreaterOrEqual, Version: upper}}
+	case OpGreaterOrEqual:
+		return Range{{Op: OpGreaterOrEqual, Version: lower}}
+	case OpLess:
+		return Range{{Op: OpLess, Version: exclusiveUpper(lower)}}
+	case OpLessOrEqual:
+		return Range{{Op: OpLess, Version: exclusiveUpper(upper)}}
+	default:
+		return Range{{Op: OpGreaterOrEqual, Version: lower}, {Op: OpLess, Version: exclusiveUpper(upper)}}
+	}
+}
+
+// caret expands '^' to the versions not changing the left-most non-zero part of those given.
+func (p partialVersion) caret() Range {
+	var upper *SemVer
+
+	switch {
+	case p.parts == 0:
+		return Range{}
+	case p.ver.Major > 0 || p.parts == 1:
+		upper = newSemVer(p.ver.Major+1, 0, 0, "")
+	case p.ver.Minor > 0 || p.parts == 2: //nolint:mnd
+		upper = newSemVer(0, p.ver.Minor+1, 0, "")
+	default:
+		upper = newSemVer(0, 0, p.ver.Patch+1, "")
+	}
+
+	return Range{{Op: OpGreaterOrEqual, Version: p.ver}, {Op: OpLess, Version: exclusiveUpper(upper)}}
+}
+
+// tilde expands '~' to the patch versions if a minor version is given and the minor versions otherwise.
+func (p partialVersion) tilde() Range {
+	switch p.parts {
+	case 0:
+		return Range{}
+	case 1:
+		return Range{{Op: OpGreaterOrEqual, Version: p.ver}, {
+			Op: OpLess, Version: exclusiveUpper(newSemVer(p.ver.Major+1, 0, 0, "")),
+		}}
+	default:
+		return Range{{Op: OpGreaterOrEqual, Version: p.ver}, {
+			Op: OpLess, Version: exclusiveUpper(newSemVer(p.ver.Major, p.ver.Minor+1, 0, "")),
+		}}
+	}
+}
+
+// pessimistic expands '~>' and '~=' to the versions where only the last given part may increase.
+func (p partialVersion) pessimistic() Range {
+	upper := newSemVer(p.ver.Major+1, 0, 0, "")
+	if p.parts == 3 { //nolint:mnd
+		upper = newSemVer(p.ver.Major, p.ver.Minor+1, 0, "")
+	}
+
+	return Range{{Op: OpGreaterOrEqual, Version: p.ver}, {Op: OpLess, Version: exclusiveUpper(upper)}}
+}
+
+// exclusiveUpper returns the lowest prerelease of a version, which excludes the prereleases of the
+// version itself when used as an exclusive upper bound.
+func exclusiveUpper(ver *SemVer) *SemVer {
+	return newSemVer(ver.Major, ver.Minor, ver.Patch, "0")
+}
+
+func newSemVer(major, minor, patch int, prerelease string) *SemVer {
+	return &SemVer{
+		Major:      major,
+		Minor:      minor,
+		Patch:      patch,
+		Prerelease: prerelease,
+		Release:    fmt.Sprintf("%d.%d.%d", major, minor, patch),
+	}
+}
+
+// Filter returns the versions satisfying a constraint expression, in their original order. Strings
+// which are not semantic versions are skipped.
+func Filter(versions []string, constraint string) ([]*SemVer, error) {
+	c, err := ParseConstraint(constraint)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []*SemVer
+
+	for _, version := range versions {
+		ver, err := ParseSemVer(version)
+		if err != nil {
+			continue
+		}
+
+		if c.Check(ver) {
+			matched = append(matched, ver)
+		}
+	}
+
+	return matched, nil
+}
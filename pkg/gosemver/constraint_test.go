@@ -0,0 +1,436 @@
+package gosemver_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+func TestParseConstraint(t *testing.T) {
+	tests := []struct {
+		name       string
+		constraint string
+		want       string
+		wantErr    error
+	}{
+		{"exact", "1.2.3", "=1.2.3", nil},
+		{"exact with v", "v1.2.3", "=1.2.3", nil},
+		{"operators", ">=1.2.3 <2.0.0", ">=1.2.3 <2.0.0", nil},
+		{"commas", ">=1.2.3, <2.0.0", ">=1.2.3 <2.0.0", nil},
+		{"space after operator", ">= 1.2.3", ">=1.2.3", nil},
+		{"or", "1.x || >=3.1.0", ">=1.0.0 <2.0.0-0 || >=3.1.0", nil},
+		{"any", "*", "*", nil},
+		{"empty", "", "*", nil},
+		{"minor wildcard", "1.2.x", ">=1.2.0 <1.3.0-0", nil},
+		{"partial", "1.2", ">=1.2.0 <1.3.0-0", nil},
+		{"greater than partial", ">1.2", ">=1.3.0", nil},
+		{"less than partial", "<1.2", "<1.2.0-0", nil},
+		{"less or equal partial", "<=1", "<2.0.0-0", nil},
+		{"greater than any", ">*", "<0.0.0-0", nil},
+		{"hyphen", "1.2.3 - 2.3.4", ">=1.2.3 <=2.3.4", nil},
+		{"hyphen partial", "1.2 - 2", ">=1.2.0 <3.0.0-0", nil},
+		{"prerelease", ">=1.0.0-rc.1", ">=1.0.0-rc.1", nil},
+		{"caret", "^1.2.3", ">=1.2.3 <2.0.0-0", nil},
+		{"caret zero major", "^0.2.3", ">=0.2.3 <0.3.0-0", nil},
+		{"caret zero minor", "^0.0.3", ">=0.0.3 <0.0.4-0", nil},
+		{"caret prerelease", "^1.2.3-beta.2", ">=1.2.3-beta.2 <2.0.0-0", nil},
+		{"caret partial", "^1.2", ">=1.2.0 <2.0.0-0", nil},
+		{"caret zero partial", "^0.0", ">=0.0.0 <0.1.0-0", nil},
+		{"caret zero major only", "^0", ">=0.0.0 <1.0.0-0", nil},
+		{"caret any", "^*", "*", nil},
+		{"tilde", "~1.2.3", ">=1.2.3 <1.3.0-0", nil},
+		{"tilde partial", "~1.2", ">=1.2.0 <1.3.0-0", nil},
+		{"tilde major", "~1", ">=1.0.0 <2.0.0-0", nil},
+		{"tilde zero", "~0.2.3", ">=0.2.3 <0.3.0-0", nil},
+		{"tilde space", "~ 1.0", ">=1.0.0 <1.1.0-0", nil},
+		{"tilde greater", "~>1.2", ">=1.2.0 <1.3.0-0", nil},
+		{"tilde greater major", "~> 2", ">=2.0.0 <3.0.0-0", nil},
+		{"tilde greater any", "~>*", "*", nil},
+		{"compatible", "~=1.4.5", "", gosemver.ErrInvalidConstraint},
+		{"invalid version", ">=1.2.3.4", "", gosemver.ErrInvalidConstraint},
+		{"wildcard before number", "1.x.3", "", gosemver.ErrInvalidConstraint},
+		{"leading zero", "01.2", "", gosemver.ErrInvalidConstraint},
+		{"operator without version", ">=", "", gosemver.ErrInvalidConstraint},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gosemver.ParseConstraint(tt.constraint)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseConstraint() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseConstraint() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSatisfies(t *testing.T) {
+	tests := []struct {
+		name       string
+		version    string
+		constraint string
+		want       bool
+		wantErr    error
+	}{
+		{"exact", "1.2.3", "1.2.3", true, nil},
+		{"exact ignores build", "1.2.3+build.1", "=1.2.3", true, nil},
+		{"lower bound", "1.2.3", ">=1.2.3 <2.0.0", true, nil},
+		{"upper bound", "2.0.0", ">=1.2.3 <2.0.0", false, nil},
+		{"wildcard", "1.9.0", "1.x", true, nil},
+		{"wildcard excludes next prerelease", "2.0.0-rc.1", "1.x", false, nil},
+		{"second range", "3.2.0", "1.x || >=3.1.0", true, nil},
+		{"no range", "2.5.0", "1.x || >=3.1.0", false, nil},
+		{"hyphen", "2.3.4", "1.2.3 - 2.3.4", true, nil},
+		{"prerelease by precedence", "1.0.0-rc.2", ">=1.0.0-rc.1", true, nil},
+		{"any", "0.0.1", "*", true, nil},
+		{"invalid version", "1.2", "*", false, gosemver.ErrInvalidVersion},
+		{"tilde greater", "1.2.9", "~> 1.2", true, nil},
+		{"tilde greater next minor", "1.3.0", "~> 1.2", false, nil},
+		{"invalid constraint", "1.2.3", ">=x.y", false, gosemver.ErrInvalidConstraint},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gosemver.Satisfies(tt.version, tt.constraint)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Satisfies() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("Satisfies() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	versions := []string{"2.1.0", "v1.4.0", "latest", "1.0.0-rc.1", "1.9.9", "3.0.0"}
+
+	tests := []struct {
+		name       string
+		constraint string
+		want       []string
+		wantErr    error
+	}{
+		{"range", ">=1.0.0 <3.0.0", []string{"2.1.0", "1.4.0", "1.9.9"}, nil},
+		{"wildcard", "1.x", []string{"1.4.0", "1.9.9"}, nil},
+		{"any excludes prereleases", "*", []string{"2.1.0", "1.4.0", "1.9.9", "3.0.0"}, nil},
+		{"prerelease of same version", ">=1.0.0-rc.0 <2.0.0", []string{"1.4.0", "1.0.0-rc.1", "1.9.9"}, nil},
+		{"none", ">=4", nil, nil},
+		{"invalid constraint", "~~1", nil, gosemver.ErrInvalidConstraint},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gosemver.Filter(versions, tt.constraint)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Filter() error = %v, want %v", err, tt.wantErr)
+			}
+
+			gotStrings := make([]string, 0, len(got))
+			for _, ver := range got {
+				gotStrings = append(gotStrings, ver.String())
+			}
+
+			if !slices.Equal(gotStrings, tt.want) {
+				t.Errorf("Filter() = %v, want %v", gotStrings, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCargoRequirement(t *testing.T) {
+	tests := []struct {
+		name        string
+		requirement string
+		want        string
+		wantErr     error
+	}{
+		{"bare is caret", "1.2.3", ">=1.2.3 <2.0.0-0", nil},
+		{"bare zero minor", "0.2", ">=0.2.0 <0.3.0-0", nil},
+		{"bare zero patch", "0.0.3", ">=0.0.3 <0.0.4-0", nil},
+		{"exact", "=1.2.3", "=1.2.3", nil},
+		{"tilde", "~1.2", ">=1.2.0 <1.3.0-0", nil},
+		{"wildcard", "1.*", ">=1.0.0 <2.0.0-0", nil},
+		{"comma", ">=1.2, <1.5", ">=1.2.0 <1.5.0-0", nil},
+		{"alternatives", "1.2 || 2.0", "", gosemver.ErrInvalidConstraint},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gosemver.ParseCargoRequirement(tt.requirement)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseCargoRequirement() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseCargoRequirement() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseGemRequirement(t *testing.T) {
+	tests := []struct {
+		name        string
+		requirement string
+		want        string
+		wantErr     error
+	}{
+		{"bare is exact", "2.2", "=2.2.0", nil},
+		{"exact", "= 2.2.1", "=2.2.1", nil},
+		{"greater partial", "> 2.2", ">2.2.0", nil},
+		{"less or equal partial", "<= 2", "<=2.0.0", nil},
+		{"pessimistic major", "~> 2", ">=2.0.0 <3.0.0-0", nil},
+		{"pessimistic minor", "~> 2.2", ">=2.2.0 <3.0.0-0", nil},
+		{"pessimistic patch", "~> 2.2.0", ">=2.2.0 <2.3.0-0", nil},
+		{"pessimistic prerelease", "~>1.2.3-rc.1", ">=1.2.3-rc.1 <1.3.0-0", nil},
+		{"pessimistic with bound", "~> 2.2, >= 2.2.1", ">=2.2.0 <3.0.0-0 >=2.2.1", nil},
+		{"pessimistic any", "~>*", "", gosemver.ErrInvalidConstraint},
+		{"caret", "^1.2", "", gosemver.ErrInvalidConstraint},
+		{"alternatives", "~> 2.2 || ~> 3.1", "", gosemver.ErrInvalidConstraint},
+		{"hyphen", "1.0 - 2.0", "", gosemver.ErrInvalidConstraint},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gosemver.ParseGemRequirement(tt.requirement)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseGemRequirement() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseGemRequirement() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePipSpecifier(t *testing.T) {
+	tests := []struct {
+		name      string
+		specifier string
+		want      string
+		wantErr   error
+	}{
+		{"equal", "==1.4", "=1.4.0", nil},
+		{"equal wildcard", "==1.4.*", ">=1.4.0 <1.5.0-0", nil},
+		{"greater partial", ">1.4", ">1.4.0", nil},
+		{"compatible minor", "~= 2.2", ">=2.2.0 <3.0.0-0", nil},
+		{"compatible patch", "~=1.4.5", ">=1.4.5 <1.5.0-0", nil},
+		{"compatible with bound", "~=1.4.5, <1.4.9", ">=1.4.5 <1.5.0-0 <1.4.9", nil},
+		{"compatible major only", "~=1", "", gosemver.ErrInvalidConstraint},
+		{"bare version", "1.4", "", gosemver.ErrInvalidConstraint},
+		{"single equal", "=1.4", "", gosemver.ErrInvalidConstraint},
+		{"tilde", "~1.4", "", gosemver.ErrInvalidConstraint},
+		{"alternatives", "==1.4 || ==1.5", "", gosemver.ErrInvalidConstraint},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gosemver.ParsePipSpecifier(tt.specifier)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParsePipSpecifier() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParsePipSpecifier() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestNpmConformance checks ranges against the range-include and range-exclude fixtures of
+// node-semver, leaving out loose versions and options.
+func TestNpmConformance(t *testing.T) {
+	include := [][2]string{
+		{"1.0.0 - 2.0.0", "1.2.3"},
+		{"^1.2.3+build", "1.2.3"},
+		{"^1.2.3+build", "1.3.0"},
+		{"1.2.3-pre+asdf - 2.4.3-pre+asdf", "1.2.3"},
+		{"1.2.3-pre+asdf - 2.4.3-pre+asdf", "1.2.3-pre.2"},
+		{"1.2.3-pre+asdf - 2.4.3-pre+asdf", "2.4.3-alpha"},
+		{"1.2.3+asdf - 2.4.3+asdf", "1.2.3"},
+		{"1.0.0", "1.0.0"},
+		{">=*", "0.2.4"},
+		{"", "1.0.0"},
+		{"*", "1.2.3"},
+		{">=1.0.0", "1.0.0"},
+		{">=1.0.0", "1.0.1"},
+		{">=1.0.0", "1.1.0"},
+		{">1.0.0", "1.0.1"},
+		{">1.0.0", "1.1.0"},
+		{"<=2.0.0", "2.0.0"},
+		{"<=2.0.0", "1.9999.9999"},
+		{"<=2.0.0", "0.2.9"},
+		{"<2.0.0", "1.9999.9999"},
+		{"<2.0.0", "0.2.9"},
+		{">= 1.0.0", "1.0.0"},
+		{">=  1.0.0", "1.0.1"},
+		{"> 1.0.0", "1.0.1"},
+		{"<=   2.0.0", "2.0.0"},
+		{"< 2.0.0", "1.9999.9999"},
+		{">=0.1.97", "v0.1.97"},
+		{">=0.1.97", "0.1.97"},
+		{"0.1.20 || 1.2.4", "1.2.4"},
+		{">=0.2.3 || <0.0.1", "0.0.0"},
+		{">=0.2.3 || <0.0.1", "0.2.3"},
+		{">=0.2.3 || <0.0.1", "0.2.4"},
+		{"||", "1.3.4"},
+		{"2.x.x", "2.1.3"},
+		{"1.2.x", "1.2.3"},
+		{"1.2.x || 2.x", "2.1.3"},
+		{"1.2.x || 2.x", "1.2.3"},
+		{"x", "1.2.3"},
+		{"2.*.*", "2.1.3"},
+		{"1.2.*", "1.2.3"},
+		{"1.2.* || 2.*", "2.1.3"},
+		{"1.2.* || 2.*", "1.2.3"},
+		{"2", "2.1.2"},
+		{"2.3", "2.3.1"},
+		{"~0.0.1", "0.0.1"},
+		{"~0.0.1", "0.0.2"},
+		{"~x", "0.0.9"},
+		{"~2", "2.0.9"},
+		{"~2.4", "2.4.0"},
+		{"~2.4", "2.4.5"},
+		{"~1", "1.2.3"},
+		{"~ 1.0", "1.0.2"},
+		{"~ 1.0.3", "1.0.12"},
+		{">=1", "1.0.0"},
+		{">= 1", "1.0.0"},
+		{"<1.2", "1.1.1"},
+		{"< 1.2", "1.1.1"},
+		{"~v0.5.4-pre", "0.5.5"},
+		{"~v0.5.4-pre", "0.5.4"},
+		{"=0.7.x", "0.7.2"},
+		{"<=0.7.x", "0.7.2"},
+		{">=0.7.x", "0.7.2"},
+		{"<=0.7.x", "0.6.2"},
+		{"~1.2.1 >=1.2.3", "1.2.3"},
+		{"~1.2.1 =1.2.3", "1.2.3"},
+		{"~1.2.1 1.2.3", "1.2.3"},
+		{"~1.2.1 >=1.2.3 1.2.3", "1.2.3"},
+		{"~1.2.1 1.2.3 >=1.2.3", "1.2.3"},
+		{">=1.2.1 1.2.3", "1.2.3"},
+		{"1.2.3 >=1.2.1", "1.2.3"},
+		{">=1.2.3 >=1.2.1", "1.2.3"},
+		{">=1.2.1 >=1.2.3", "1.2.3"},
+		{">=1.2", "1.2.8"},
+		{"^1.2.3", "1.8.1"},
+		{"^0.1.2", "0.1.2"},
+		{"^0.1", "0.1.2"},
+		{"^0.0.1", "0.0.1"},
+		{"^1.2", "1.4.2"},
+		{"^1.2 ^1", "1.4.2"},
+		{"^1.2.3-alpha", "1.2.3-pre"},
+		{"^1.2.0-alpha", "1.2.0-pre"},
+		{"^0.0.1-alpha", "0.0.1-beta"},
+		{"^0.0.1-alpha", "0.0.1"},
+		{"^0.1.1-alpha", "0.1.1-beta"},
+		{"^x", "1.2.3"},
+		{"x - 1.0.0", "0.9.7"},
+		{"x - 1.x", "0.9.7"},
+		{"1.0.0 - x", "1.9.7"},
+		{"1.x - x", "1.9.7"},
+		{"<=7.x", "7.9.9"},
+	}
+
+	exclude := [][2]string{
+		{"1.0.0 - 2.0.0", "2.2.3"},
+		{"1.2.3+asdf - 2.4.3+asdf", "1.2.3-pre.2"},
+		{"1.2.3+asdf - 2.4.3+asdf", "2.4.3-alpha"},
+		{"^1.2.3+build", "2.0.0"},
+		{"^1.2.3+build", "1.2.0"},
+		{"^1.2.3", "1.2.3-pre"},
+		{"^1.2", "1.2.0-pre"},
+		{">1.2", "1.3.0-beta"},
+		{"<=1.2.3", "1.2.3-beta"},
+		{"^1.2.3", "1.2.3-beta"},
+		{"=0.7.x", "0.7.0-asdf"},
+		{">=0.7.x", "0.7.0-asdf"},
+		{"<=0.7.x", "0.7.0-asdf"},
+		{"1.0.0", "1.0.1"},
+		{">=1.0.0", "0.0.0"},
+		{">=1.0.0", "0.0.1"},
+		{">=1.0.0", "0.1.0"},
+		{">1.0.0", "0.0.1"},
+		{">1.0.0", "0.1.0"},
+		{"<=2.0.0", "3.0.0"},
+		{"<=2.0.0", "2.9999.9999"},
+		{"<=2.0.0", "2.2.9"},
+		{"<2.0.0", "2.9999.9999"},
+		{"<2.0.0", "2.2.9"},
+		{">=0.1.97", "v0.1.93"},
+		{">=0.1.97", "0.1.93"},
+		{"0.1.20 || 1.2.4", "1.2.3"},
+		{">=0.2.3 || <0.0.1", "0.0.3"},
+		{">=0.2.3 || <0.0.1", "0.2.2"},
+		{"2.x.x", "1.1.3"},
+		{"2.x.x", "3.1.3"},
+		{"1.2.x", "1.3.3"},
+		{"1.2.x || 2.x", "3.1.3"},
+		{"1.2.x || 2.x", "1.1.3"},
+		{"2.*.*", "1.1.3"},
+		{"2.*.*", "3.1.3"},
+		{"1.2.*", "1.3.3"},
+		{"2", "1.1.2"},
+		{"2.3", "2.4.1"},
+		{"~0.0.1", "0.1.0-alpha"},
+		{"~0.0.1", "0.1.0"},
+		{"~2.4", "2.5.0"},
+		{"~2.4", "2.3.9"},
+		{"~1", "0.2.3"},
+		{"~1.0", "1.1.0"},
+		{"<1", "1.0.0"},
+		{">=1.2", "1.1.1"},
+		{"~v0.5.4-beta", "0.5.4-alpha"},
+		{"=0.7.x", "0.8.2"},
+		{">=0.7.x", "0.6.2"},
+		{"<0.7.x", "0.7.2"},
+		{"<1.2.3", "1.2.3-beta"},
+		{"=1.2.3", "1.2.3-beta"},
+		{">1.2", "1.2.8"},
+		{"^0.0.1", "0.0.2-alpha"},
+		{"^0.0.1", "0.0.2"},
+		{"^1.2.3", "2.0.0-alpha"},
+		{"^1.2.3", "1.2.2"},
+		{"^1.2", "1.1.9"},
+		{"*", "v1.2.3-foo"},
+		{"^1.0.0", "2.0.0-rc1"},
+		{"^1.0.0", "1.0.0-rc1"},
+		{"1 - 2", "3.0.0-pre"},
+		{"1 - 2", "2.0.0-pre"},
+		{"1 - 2", "1.0.0-pre"},
+		{"1.0 - 2", "1.0.0-pre"},
+		{"1.1.x", "1.0.0-a"},
+		{"1.1.x", "1.1.0-a"},
+		{"1.1.x", "1.2.0-a"},
+		{"1.x", "1.0.0-a"},
+		{"1.x", "1.1.0-a"},
+		{"1.x", "1.2.0-a"},
+		{">=1.0.0 <1.1.0", "1.1.0"},
+		{">=1.0.0 <1.1.0", "1.1.0-pre"},
+		{">=1.0.0 <1.1.0-pre", "1.1.0-pre"},
+	}
+
+	for want, fixtures := range map[bool][][2]string{true: include, false: exclude} {
+		for _, fixture := range fixtures {
+			got, err := gosemver.Satisfies(fixture[1], fixture[0])
+			if err != nil {
+				t.Errorf("Satisfies(%q, %q) error = %v", fixture[1], fixture[0], err)
+
+				continue
+			}
+
+			if got != want {
+				t.Errorf("Satisfies(%q, %q) = %v, want %v", fixture[1], fixture[0], got, want)
+			}
+		}
+	}
+}
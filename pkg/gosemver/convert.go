@@ -0,0 +1,328 @@
+package gosemver
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// SyntaxGo is a minimum version as required in go.mod, which by minimal version selection allows
+// any later version of the same major version: 'v1.2.3' means '>=1.2.3 <2.0.0-0'. Major versions 0
+// and 1 share a module path, so 'v0.4.0' means '>=0.4.0 <2.0.0-0'.
+const SyntaxGo = "go"
+
+var ErrInexpressible = errors.New("constraint cannot be expressed exactly in the target syntax")
+
+// ParseGoRequirement parses a go.mod minimum version like 'v1.2.3'.
+func ParseGoRequirement(constraint string) (*Constraint, error) {
+	ver, err := ParseSemVer(strings.TrimSpace(constraint))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %w", ErrInvalidConstraint, constraint, err)
+	}
+
+	return NewConstraint([]Interval{{
+		Lower: Bound{Version: ver, Inclusive: true},
+		Upper: Bound{Version: exclusiveUpper(goMajorLimit(ver.Major))},
+	}}), nil
+}
+
+// maxPrereleaseVersions limits the versions whose prereleases a constraint lists one by one.
+const maxPrereleaseVersions = 100
+
+// ConvertConstraint parses a constraint in one syntax and formats it in another.
+func ConvertConstraint(constraint, from, to string) (string, error) {
+	c, err := ParseConstraintSyntax(constraint, from)
+	if err != nil {
+		return "", err
+	}
+
+	return FormatConstraint(c.VersionSet(), to)
+}
+
+// ConvertReleases parses a constraint in one syntax and formats its releases in another, the
+// prereleases matched along with them following the rules of the target syntax.
+func ConvertReleases(constraint, from, to string) (string, error) {
+	c, err := ParseConstraintSyntax(constraint, from)
+	if err != nil {
+		return "", err
+	}
+
+	return FormatReleases(c.VersionSet(), to)
+}
+
+// FormatReleases returns the releases of the set as a constraint in the given syntax, whichever
+// prereleases the syntax matches along with them: '>=1.2.3 <2.0.0' becomes '[1.2.3,2.0.0-0)' in
+// Maven. It fails with ErrInexpressible if the syntax cannot describe the releases exactly.
+func FormatReleases(set VersionSet, syntax string) (string, error) {
+	formatted, err := formatConstraint(VersionSet{Releases: set.Releases}, syntax)
+	if err != nil {
+		return "", err
+	}
+
+	c, err := ParseConstraintSyntax(formatted, syntax)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInexpressible, err)
+	}
+
+	if !c.VersionSet().Releases.Equal(set.Releases) {
+		return "", fmt.Errorf("%w: %q matches other releases in the %s syntax", ErrInexpressible, formatted, syntax)
+	}
+
+	return formatted, nil
+}
+
+// FormatConstraint returns the set of versions as a constraint in the given syntax. The constraint is
+// parsed back to check that it matches the same versions under the prerelease rule of the syntax, it
+// fails with ErrInexpressible if the syntax cannot describe the set exactly.
+func FormatConstraint(set VersionSet, syntax string) (string, error) {
+	formatted, err := formatConstraint(set, syntax)
+	if err != nil {
+		return "", err
+	}
+
+	c, err := ParseConstraintSyntax(formatted, syntax)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInexpressible, err)
+	}
+
+	if !c.VersionSet().Equal(set) {
+		return "", fmt.Errorf("%w: %q matches other prereleases in the %s syntax", ErrInexpressible, formatted, syntax)
+	}
+
+	return formatted, nil
+}
+
+func formatConstraint(set VersionSet, syntax string) (string, error) {
+	switch syntax {
+	case SyntaxNative, SyntaxNpm, "":
+		ranges, err := npmRanges(set)
+		if err != nil {
+			return "", err
+		}
+
+		return (&Constraint{Ranges: ranges}).String(), nil
+	case SyntaxCargo, SyntaxGem, SyntaxPip:
+		ranges, err := npmRanges(set)
+		if err != nil {
+			return "", err
+		}
+
+		return formatRequirement(ranges, syntax)
+	case SyntaxMaven:
+		return FormatMavenRange(NewConstraint(mavenIntervals(set))), nil
+	case SyntaxGo:
+		return formatGo(precedenceIntervals(set))
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnknownSyntax, syntax)
+	}
+}
+
+// npmRanges returns ranges matching the versions of the set under the npm prerelease rule: one per
+// interval of releases, taking in the prereleases of the versions at its bounds, and one per version
+// with other prereleases in the set.
+func npmRanges(set VersionSet) ([]Range, error) {
+	pieces, err := prereleasePieces(set.Prereleases)
+	if err != nil {
+		return nil, err
+	}
+
+	intervals := make([]Interval, 0, len(set.Releases)+len(pieces))
+
+	for _, interval := range set.Releases {
+		lower := interval.Lower.Version
+		if lower == nil {
+			lower = newSemVer(0, 0, 0, "")
+		}
+
+		k := slices.IndexFunc(pieces, func(piece Interval) bool { return compareVersions(piece.Upper.Version, lower) == 0 })
+		if k >= 0 {
+			interval.Lower = pieces[k].Lower
+			pieces = slices.Delete(pieces, k, k+1)
+		}
+
+		if upper := interval.Upper.Version; upper != nil {
+			k := slices.IndexFunc(pieces, func(piece Interval) bool {
+				return compareVersions(piece.Lower.Version, exclusiveUpper(upper)) == 0 &&
+					piece.Upper.Version.Prerelease != ""
+			})
+			if k >= 0 {
+				interval.Upper = pieces[k].Upper
+				pieces = slices.Delete(pieces, k, k+1)
+			}
+		}
+
+		intervals = append(intervals, interval)
+	}
+
+	intervals = append(intervals, pieces...)
+	slices.SortStableFunc(intervals, func(a, b Interval) int { return compareLower(a.Lower, b.Lower) })
+
+	ranges := make([]Range, 0, len(intervals))
+	for _, interval := range intervals {
+		ranges = append(ranges, exactInterval(interval).Range())
+	}
+
+	return ranges, nil
+}
+
+// prereleasePieces splits the prereleases of the set by the version they are prereleases of. A piece
+// holding the last prereleases of a version ends with the version itself.
+func prereleasePieces(set IntervalSet) ([]Interval, error) {
+	var pieces []Interval
+
+	for _, interval := range set {
+		lower, upper := lowestVersion, interval.Upper.Version
+		if interval.Lower.Version != nil {
+			lower = interval.Lower.Version
+		}
+
+		switch {
+		case upper == nil:
+			return nil, fmt.Errorf("%w: a range matches prereleases only of the versions it names, not all from %s on",
+				ErrInexpressible, lower)
+		case upper.Major != lower.Major || upper.Minor != lower.Minor || upper.Patch-lower.Patch > maxPrereleaseVersions:
+			return nil, fmt.Errorf("%w: a range matches prereleases only of the versions it names, not all from %s below %s",
+				ErrInexpressible, lower, upper)
+		}
+
+		for patch := lower.Patch; patch <= upper.Patch; patch++ {
+			release := newSemVer(lower.Major, lower.Minor, patch, "")
+			piece := Interval{Lower: Bound{Version: exclusiveUpper(release), Inclusive: true}, Upper: Bound{Version: release}}
+
+			if patch == lower.Patch {
+				piece.Lower.Version = lower
+			}
+
+			if patch == upper.Patch {
+				piece.Upper.Version = upper
+			}
+
+			if !piece.IsEmpty() {
+				pieces = append(pieces, piece)
+			}
+		}
+	}
+
+	return pieces, nil
+}
+
+// exactInterval returns an interval holding a single version of a VersionSet as that version alone.
+func exactInterval(interval Interval) Interval {
+	lower, upper := interval.Lower.Version, interval.Upper.Version
+	if lower == nil || upper == nil {
+		return interval
+	}
+
+	next := successor(lower)
+	if lower.Prerelease == "" {
+		next = newSemVer(lower.Major, lower.Minor, lower.Patch+1, "")
+	}
+
+	if compareVersions(upper, next) == 0 {
+		interval.Upper = Bound{Version: lower, Inclusive: true}
+	}
+
+	return interval
+}
+
+// precedenceIntervals returns intervals holding the versions of the set by precedence, if any: the
+// releases of an interval bring along the prereleases between them.
+func precedenceIntervals(set VersionSet) IntervalSet {
+	intervals := make([]Interval, 0, len(set.Releases)+len(set.Prereleases))
+
+	for _, interval := range set.Releases {
+		if upper := interval.Upper.Version; upper != nil {
+			interval.Upper.Version = exclusiveUpper(upper)
+		}
+
+		intervals = append(intervals, interval)
+	}
+
+	for _, interval := range set.Prereleases {
+		if upper := interval.Upper.Version; upper != nil && upper.Prerelease == "0" && upper.Patch > 0 {
+			interval.Upper.Version = newSemVer(upper.Major, upper.Minor, upper.Patch-1, "")
+		}
+
+		intervals = append(intervals, interval)
+	}
+
+	return NewIntervalSet(intervals...)
+}
+
+// mavenIntervals returns the precedence intervals of the set with bounds on the lowest prerelease of
+// a version written as bounds on the release before it: '[1.2.4-0,' becomes '(1.2.3,'.
+func mavenIntervals(set VersionSet) IntervalSet {
+	intervals := precedenceIntervals(set)
+
+	for i, interval := range intervals {
+		if lower := interval.Lower.Version; lower != nil && lower.Prerelease == "0" && lower.Patch > 0 {
+			intervals[i].Lower = Bound{Version: newSemVer(lower.Major, lower.Minor, lower.Patch-1, "")}
+		}
+
+		if upper := interval.Upper.Version; upper != nil && upper.Prerelease == "0" && upper.Patch > 0 {
+			intervals[i].Upper = Bound{Version: newSemVer(upper.Major, upper.Minor, upper.Patch-1, ""), Inclusive: true}
+		}
+	}
+
+	return intervals
+}
+
+// formatRequirement formats a single range as the comma separated comparators of a Cargo or RubyGems
+// requirement or a Python version specifier.
+func formatRequirement(ranges []Range, syntax string) (string, error) {
+	separator := " "
+	if syntax == SyntaxCargo {
+		separator = ""
+	}
+
+	switch len(ranges) {
+	case 0:
+		return OpLess + separator + lowestVersion.String(), nil
+	case 1:
+	default:
+		return "", fmt.Errorf("%w: %s requirements cannot have alternatives", ErrInexpressible, syntax)
+	}
+
+	r := ranges[0]
+	if len(r) == 0 && syntax == SyntaxCargo {
+		return "*", nil
+	} else if len(r) == 0 {
+		return OpGreaterOrEqual + separator + newSemVer(0, 0, 0, "").String(), nil
+	}
+
+	comparators := make([]string, 0, len(r))
+	for _, comparator := range r {
+		op := comparator.Op
+		if op == OpEqual && syntax == SyntaxPip {
+			op = opPipEqual
+		}
+
+		comparators = append(comparators, op+separator+comparator.Version.String())
+	}
+
+	return strings.Join(comparators, ", "), nil
+}
+
+func formatGo(set IntervalSet) (string, error) {
+	if len(set) != 1 {
+		return "", fmt.Errorf("%w: go.mod requires a single minimum version", ErrInexpressible)
+	}
+
+	lower, upper := set[0].Lower, set[0].Upper
+	if lower.Version == nil || !lower.Inclusive || upper.Version == nil || upper.Inclusive {
+		return "", fmt.Errorf("%w: go.mod requires a minimum version up to the next major version", ErrInexpressible)
+	}
+
+	limit := goMajorLimit(lower.Version.Major)
+	if upper.Version.Release != limit.Release || (upper.Version.Prerelease != "" && upper.Version.Prerelease != "0") {
+		return "", fmt.Errorf("%w: go.mod allows all versions from %s below %s", ErrInexpressible, lower.Version, limit)
+	}
+
+	return "v" + lower.Version.String(), nil
+}
+
+// goMajorLimit returns the first version of the next module path after a major version.
+func goMajorLimit(major int) *SemVer {
+	return newSemVer(max(major, 1)+1, 0, 0, "")
+}
@@ -0,0 +1,102 @@
+package gosemver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+func TestConvertConstraint(t *testing.T) {
+	tests := []struct {
+		name       string
+		constraint string
+		from       string
+		to         string
+		want       string
+		wantErr    error
+	}{
+		{"npm to maven", "^1.2.3", gosemver.SyntaxNpm, gosemver.SyntaxMaven, "", gosemver.ErrInexpressible},
+		{"npm exact to maven", "1.2.3 || 1.3.0", gosemver.SyntaxNpm, gosemver.SyntaxMaven, "[1.2.3],[1.3.0]", nil},
+		{"npm prereleases to maven", ">=1.2.3-rc.1 <1.2.3", gosemver.SyntaxNpm, gosemver.SyntaxMaven, "[1.2.3-rc.1,1.2.3)", nil},
+		{"maven to npm", "[1.2,2.0)", gosemver.SyntaxMaven, gosemver.SyntaxNpm, "", gosemver.ErrInexpressible},
+		{"maven soft requirement to npm", "[1.0,)", gosemver.SyntaxMaven, gosemver.SyntaxNpm, "", gosemver.ErrInexpressible},
+		{"maven patch range to npm", "[1.2.3,1.2.4)", gosemver.SyntaxMaven, gosemver.SyntaxNpm, "=1.2.3 || >=1.2.4-0 <1.2.4", nil},
+		{"maven to cargo", "[1.2,2.0)", gosemver.SyntaxMaven, gosemver.SyntaxCargo, "", gosemver.ErrInexpressible},
+		{"maven exact to cargo", "[1.2.3]", gosemver.SyntaxMaven, gosemver.SyntaxCargo, "=1.2.3", nil},
+		{"maven union to cargo", "(,1.0],[1.2,)", gosemver.SyntaxMaven, gosemver.SyntaxCargo, "", gosemver.ErrInexpressible},
+		{"cargo to npm", "0.2.3", gosemver.SyntaxCargo, gosemver.SyntaxNpm, ">=0.2.3 <0.3.0", nil},
+		{"npm to go", "^1.2.3", gosemver.SyntaxNpm, gosemver.SyntaxGo, "", gosemver.ErrInexpressible},
+		{"maven to go", "[1.2.3,2.0.0-0)", gosemver.SyntaxMaven, gosemver.SyntaxGo, "v1.2.3", nil},
+		{"maven zero major to go", "[0.4.0,2.0.0-0)", gosemver.SyntaxMaven, gosemver.SyntaxGo, "v0.4.0", nil},
+		{"maven caret zero to go", "[0.4.0,0.5.0-0)", gosemver.SyntaxMaven, gosemver.SyntaxGo, "", gosemver.ErrInexpressible},
+		{"maven exclusive to go", "(1.2.3,2.0.0-0)", gosemver.SyntaxMaven, gosemver.SyntaxGo, "v1.2.4-0", nil},
+		{"maven unbounded to go", "[1.2.3,)", gosemver.SyntaxMaven, gosemver.SyntaxGo, "", gosemver.ErrInexpressible},
+		{"go to maven", "v1.2.3", gosemver.SyntaxGo, gosemver.SyntaxMaven, "[1.2.3,2.0.0-0)", nil},
+		{"go zero major to maven", "v0.4.0", gosemver.SyntaxGo, gosemver.SyntaxMaven, "[0.4.0,2.0.0-0)", nil},
+		{"go to npm", "v2.1.0", gosemver.SyntaxGo, gosemver.SyntaxNpm, "", gosemver.ErrInexpressible},
+		{"normalized", "1.2.x || ^1.0.0", gosemver.SyntaxNpm, gosemver.SyntaxCargo, ">=1.0.0, <2.0.0", nil},
+		{"any to cargo", "*", gosemver.SyntaxNpm, gosemver.SyntaxCargo, "*", nil},
+		{"invalid go", "^1.2.3", gosemver.SyntaxGo, gosemver.SyntaxNpm, "", gosemver.ErrInvalidConstraint},
+		{"unknown target", "1.x", gosemver.SyntaxNpm, "gradle", "", gosemver.ErrUnknownSyntax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gosemver.ConvertConstraint(tt.constraint, tt.from, tt.to)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ConvertConstraint() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("ConvertConstraint() = %q, want %q", got, tt.want)
+			}
+
+			if err == nil {
+				checkProbes(t, mustConstraint(t, got, tt.to).VersionSet(), mustConstraint(t, tt.constraint, tt.from).Check)
+			}
+		})
+	}
+}
+
+func TestConvertReleases(t *testing.T) {
+	tests := []struct {
+		name       string
+		constraint string
+		from       string
+		to         string
+		want       string
+		wantErr    error
+	}{
+		{"npm to maven", "^1.2.3", gosemver.SyntaxNpm, gosemver.SyntaxMaven, "[1.2.3,2.0.0-0)", nil},
+		{"npm unbounded to maven", ">=1.2.3", gosemver.SyntaxNpm, gosemver.SyntaxMaven, "[1.2.3,)", nil},
+		{"npm union to maven", "<1.0.0 || >=1.2.0", gosemver.SyntaxNpm, gosemver.SyntaxMaven, "(,1.0.0-0),[1.2.0,)", nil},
+		{"npm patch range to maven", ">=1.2.3 <=1.2.4", gosemver.SyntaxNpm, gosemver.SyntaxMaven, "[1.2.3,1.2.4]", nil},
+		{"maven to npm", "[1.2,2.0)", gosemver.SyntaxMaven, gosemver.SyntaxNpm, ">=1.2.0 <2.0.0", nil},
+		{"maven to cargo", "[1.2,2.0)", gosemver.SyntaxMaven, gosemver.SyntaxCargo, ">=1.2.0, <2.0.0", nil},
+		{"npm to go", "^1.2.3", gosemver.SyntaxNpm, gosemver.SyntaxGo, "v1.2.3", nil},
+		{"go to npm", "v0.4.0", gosemver.SyntaxGo, gosemver.SyntaxNpm, ">=0.4.0 <2.0.0", nil},
+		{"maven union to cargo", "(,1.0],[1.2,)", gosemver.SyntaxMaven, gosemver.SyntaxCargo, "", gosemver.ErrInexpressible},
+		{"npm caret zero to go", "^0.4.0", gosemver.SyntaxNpm, gosemver.SyntaxGo, "", gosemver.ErrInexpressible},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gosemver.ConvertReleases(tt.constraint, tt.from, tt.to)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ConvertReleases() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("ConvertReleases() = %q, want %q", got, tt.want)
+			}
+
+			if err == nil {
+				converted := mustConstraint(t, got, tt.to).VersionSet().Releases
+				if !converted.Equal(mustConstraint(t, tt.constraint, tt.from).VersionSet().Releases) {
+					t.Errorf("ConvertReleases() = %q matches other releases than %q", got, tt.constraint)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package gosemver
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// DockerTagMaxLength is the maximum length of an OCI image tag.
+const DockerTagMaxLength = 128
+
+// LatestDockerTag is the floating tag of the highest release.
+const LatestDockerTag = "latest"
+
+var invalidDockerTagChars = regexp.MustCompile(`[^A-Za-z0-9_.-]`)
+
+// SanitizeDockerTag converts a string into a valid OCI image tag: characters other than letters,
+// digits, '_', '.' and '-' are replaced with '-', a leading '.' or '-' is replaced with '_' and
+// the result is truncated to 128 characters.
+func SanitizeDockerTag(s string) string {
+	s = invalidDockerTagChars.ReplaceAllString(s, "-")
+	if s != "" && (s[0] == '.' || s[0] == '-') {
+		s = "_" + s[1:]
+	}
+
+	return truncate(DockerTagMaxLength, s)
+}
+
+// DockerTags returns the image tags for a release: the version itself and the floating tags
+// '<major>.<minor>', '<major>' and 'latest' for which the version is the highest among the
+// existing versions. Prereleases only get their own tag. Existing tags which are not semantic
+// versions, e.g. floating tags, and prereleases are ignored.
+func DockerTags(version string, existing []string) ([]string, error) {
+	ver, err := ParseSemVer(version)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := []string{SanitizeDockerTag(ver.String())}
+	if ver.Prerelease != "" {
+		return tags, nil
+	}
+
+	highestMinor, highestMajor, highest := true, true, true
+
+	for _, tag := range existing {
+		other, err := ParseSemVer(tag)
+		if err != nil || other.Prerelease != "" {
+			continue
+		}
+
+		cmp, err := CompareSemVer(ver.String(), other.String())
+		if err != nil {
+			return nil, err
+		}
+
+		if cmp >= 0 {
+			continue
+		}
+
+		highest = false
+		if other.Major == ver.Major {
+			highestMajor = false
+			if other.Minor == ver.Minor {
+				highestMinor = false
+			}
+		}
+	}
+
+	if highestMinor {
+		tags = append(tags, fmt.Sprintf("%d.%d", ver.Major, ver.Minor))
+	}
+
+	if highestMajor {
+		tags = append(tags, fmt.Sprintf("%d", ver.Major))
+	}
+
+	if highest {
+		tags = append(tags, LatestDockerTag)
+	}
+
+	return tags, nil
+}
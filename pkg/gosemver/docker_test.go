@@ -0,0 +1,70 @@
+package gosemver_test
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+func TestSanitizeDockerTag(t *testing.T) {
+	tests := []struct {
+		name string
+		tag  string
+		want string
+	}{
+		{"valid", "1.2.3-rc.1", "1.2.3-rc.1"},
+		{"build metadata", "1.2.3+sha.abc", "1.2.3-sha.abc"},
+		{"slash", "feature/login", "feature-login"},
+		{"leading dot", ".hidden", "_hidden"},
+		{"leading dash", "-rc", "_rc"},
+		{"too long", strings.Repeat("a", 200), strings.Repeat("a", gosemver.DockerTagMaxLength)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gosemver.SanitizeDockerTag(tt.tag); got != tt.want {
+				t.Errorf("SanitizeDockerTag() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDockerTags(t *testing.T) {
+	existing := []string{"1.3.0", "1.4.0", "1.4.1", "2.0.0", "2.1.0-rc.1", "1.4", "1", "latest", "v0.9.0"}
+
+	tests := []struct {
+		name     string
+		version  string
+		existing []string
+		want     []string
+		wantErr  error
+	}{
+		{"first release", "1.0.0", nil, []string{"1.0.0", "1.0", "1", "latest"}, nil},
+		{"highest in minor line only", "1.4.2", existing, []string{"1.4.2", "1.4", "1"}, nil},
+		{"old minor line", "1.3.1", existing, []string{"1.3.1", "1.3"}, nil},
+		{"patch of old line", "1.4.0", []string{"1.4.1"}, []string{"1.4.0"}, nil},
+		{"highest overall", "2.0.1", existing, []string{"2.0.1", "2.0", "2", "latest"}, nil},
+		{"existing prereleases ignored", "2.1.0", existing, []string{"2.1.0", "2.1", "2", "latest"}, nil},
+		{"republished release", "2.0.0", existing, []string{"2.0.0", "2.0", "2", "latest"}, nil},
+		{"prerelease", "3.0.0-rc.1", existing, []string{"3.0.0-rc.1"}, nil},
+		{"build metadata", "2.0.1+sha.abc", existing, []string{"2.0.1-sha.abc", "2.0", "2", "latest"}, nil},
+		{"v prefix", "v2.0.1", existing, []string{"2.0.1", "2.0", "2", "latest"}, nil},
+		{"invalid version", "1.2", nil, nil, gosemver.ErrInvalidVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gosemver.DockerTags(tt.version, tt.existing)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DockerTags() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("DockerTags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
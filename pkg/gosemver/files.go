@@ -0,0 +1,465 @@
+package gosemver
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	ErrInvalidSelector  = errors.New("invalid version selector")
+	ErrSelectorNotFound = errors.New("version selector not found")
+	ErrUnsupportedValue = errors.New("version value cannot be rewritten in place")
+
+	// dottedSelector matches the dotted keys selecting YAML and TOML values.
+	dottedSelector = regexp.MustCompile(`^\.?[\w-]+(?:\.[\w-]+)*$`)
+)
+
+// RegexSelectorPrefix marks a selector as a regular expression whose first capture group is the version.
+const RegexSelectorPrefix = "regex:"
+
+// DefaultGoVersionSelector finds a Version constant or variable in Go source files.
+const DefaultGoVersionSelector = RegexSelectorPrefix + `(?m)^\s*(?:const\s+|var\s+)?Version\s*(?:string\s*)?=\s*"([^"]*)"`
+
+// VersionFile points to a version in a project file. The selector syntax depends on the file type:
+// a JSON pointer for .json files, a dotted path for .yaml/.yml and .toml files, or a regular
+// expression prefixed with "regex:" for any file. Without a selector the whole file is the
+// version, except for well-known files like package.json, Chart.yaml, Cargo.toml, pyproject.toml
+// and Go sources.
+type VersionFile struct {
+	Path     string
+	Selector string
+}
+
+// ParseVersionFile parses a "<path>[:<selector>]" specification. The path ends before the first
+// "regex:" selector or else the last ':' followed by a JSON pointer or a dotted key, so that paths
+// with a drive letter like 'C:\proj\Cargo.toml:package.version' keep their colon.
+func ParseVersionFile(spec string) VersionFile {
+	if i := strings.Index(spec, ":"+RegexSelectorPrefix); i >= 0 {
+		return VersionFile{Path: spec[:i], Selector: spec[i+1:]}
+	}
+
+	for i := strings.LastIndexByte(spec, ':'); i >= 0; i = strings.LastIndexByte(spec[:i], ':') {
+		if i == 1 && isDriveLetter(spec[0]) && (len(spec) == 2 || spec[2] == '\\' || spec[2] == '/') {
+			break
+		}
+
+		if selector := spec[i+1:]; dottedSelector.MatchString(selector) || strings.HasPrefix(selector, "/") {
+			return VersionFile{Path: spec[:i], Selector: selector}
+		}
+	}
+
+	return VersionFile{Path: spec}
+}
+
+func isDriveLetter(c byte) bool {
+	return 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z'
+}
+
+// String returns the "<path>[:<selector>]" specification of the version file.
+func (f VersionFile) String() string {
+	if f.Selector == "" {
+		return f.Path
+	}
+
+	return f.Path + ":" + f.Selector
+}
+
+// Read returns the version stored in the file.
+func (f VersionFile) Read() (string, error) {
+	content, err := os.ReadFile(f.Path)
+	if err != nil {
+		return "", err
+	}
+
+	start, end, err := LocateVersion(f.Path, content, f.Selector)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", f, err)
+	}
+
+	return string(content[start:end]), nil
+}
+
+// Write replaces the version stored in the file, keeping the rest of the content untouched.
+func (f VersionFile) Write(version string) error {
+	content, err := os.ReadFile(f.Path)
+	if err != nil {
+		return err
+	}
+
+	updated, err := ReplaceVersion(f.Path, content, f.Selector, version)
+	if err != nil {
+		return fmt.Errorf("%s: %w", f, err)
+	}
+
+	return WriteFileAtomic(f.Path, updated)
+}
+
+// ReplaceVersion returns content with the version at the selector replaced.
+func ReplaceVersion(path string, content []byte, selector, version string) ([]byte, error) {
+	start, end, err := LocateVersion(path, content, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := make([]byte, 0, len(content)-(end-start)+len(version))
+	updated = append(updated, content[:start]...)
+	updated = append(updated, version...)
+
+	return append(updated, content[end:]...), nil
+}
+
+// WriteFileAtomic replaces a file by writing a temporary file next to it and renaming it,
+// preserving the file mode.
+func WriteFileAtomic(path string, content []byte) error {
+	tmp, err := writeTemp(path, content)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp) //nolint:errcheck,gosec
+
+		return err
+	}
+
+	return nil
+}
+
+// writeTemp writes content to a temporary file next to path with the same file mode.
+func writeTemp(path string, content []byte) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return "", err
+	}
+
+	_, err = tmp.Write(content)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Chmod(tmp.Name(), info.Mode())
+	}
+
+	if err != nil {
+		os.Remove(tmp.Name()) //nolint:errcheck,gosec
+
+		return "", err
+	}
+
+	return tmp.Name(), nil
+}
+
+// DefaultSelector returns the selector used for a file when none is given.
+func DefaultSelector(path string) string {
+	switch base := filepath.Base(path); {
+	case base == "package.json" || base == "composer.json":
+		return "/version"
+	case base == "Cargo.toml":
+		return "package.version"
+	case base == "pyproject.toml":
+		return "project.version"
+	case base == "Chart.yaml":
+		return "version"
+	case strings.HasSuffix(base, ".go"):
+		return DefaultGoVersionSelector
+	default:
+		return ""
+	}
+}
+
+// LocateVersion returns the byte range of the version value selected in content.
+func LocateVersion(path string, content []byte, selector string) (int, int, error) {
+	if selector == "" {
+		selector = DefaultSelector(path)
+	}
+
+	if pattern, ok := strings.CutPrefix(selector, RegexSelectorPrefix); ok {
+		return locateRegex(content, pattern)
+	}
+
+	if selector == "" {
+		start := len(content) - len(bytes.TrimLeft(content, " \t\r\n"))
+		end := len(bytes.TrimRight(content, " \t\r\n"))
+
+		return start, max(start, end), nil
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return locateJSON(content, selector)
+	case ".yaml", ".yml":
+		return locateYAML(content, selector)
+	case ".toml":
+		return locateTOML(content, selector)
+	default:
+		return 0, 0, fmt.Errorf("%w: %s: use a %s selector for %s", ErrInvalidSelector, selector, RegexSelectorPrefix, path)
+	}
+}
+
+func locateRegex(content []byte, pattern string) (int, int, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %w", ErrInvalidSelector, err)
+	}
+
+	if re.NumSubexp() < 1 {
+		return 0, 0, fmt.Errorf("%w: %s has no capture group", ErrInvalidSelector, pattern)
+	}
+
+	loc := re.FindSubmatchIndex(content)
+	if loc == nil || loc[2] < 0 {
+		return 0, 0, fmt.Errorf("%w: %s", ErrSelectorNotFound, pattern)
+	}
+
+	return loc[2], loc[3], nil
+}
+
+// locateJSON finds the string value at a JSON pointer (RFC 6901).
+func locateJSON(content []byte, pointer string) (int, int, error) {
+	if !strings.HasPrefix(pointer, "/") {
+		return 0, 0, fmt.Errorf("%w: JSON pointer must start with '/': %s", ErrInvalidSelector, pointer)
+	}
+
+	refs := strings.Split(pointer[1:], "/")
+	for i, ref := range refs {
+		refs[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(ref)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(content))
+
+	for _, ref := range refs {
+		if err := seekJSON(dec, ref); err != nil {
+			return 0, 0, fmt.Errorf("%s: %w", pointer, err)
+		}
+	}
+
+	tok, err := dec.Token()
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %w", ErrInvalidSelector, err)
+	}
+
+	value, ok := tok.(string)
+	if !ok {
+		return 0, 0, fmt.Errorf("%w: %s is not a string", ErrUnsupportedValue, pointer)
+	}
+
+	end := int(dec.InputOffset()) - 1 // closing quote
+	start := bytes.LastIndexByte(content[:end], '"') + 1
+
+	if string(content[start:end]) != value {
+		return 0, 0, fmt.Errorf("%w: %s contains escape sequences", ErrUnsupportedValue, pointer)
+	}
+
+	return start, end, nil
+}
+
+// seekJSON advances the decoder to the value of the object key or array index ref.
+func seekJSON(dec *json.Decoder, ref string) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSelector, err)
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return fmt.Errorf("%w: %w", ErrInvalidSelector, err)
+			}
+
+			if key == ref {
+				return nil
+			}
+
+			if err := skipJSON(dec); err != nil {
+				return err
+			}
+		}
+	case json.Delim('['):
+		index, err := strconv.Atoi(ref)
+		if err != nil {
+			return fmt.Errorf("%w: %s is not an array index", ErrInvalidSelector, ref)
+		}
+
+		for i := 0; dec.More(); i++ {
+			if i == index {
+				return nil
+			}
+
+			if err := skipJSON(dec); err != nil {
+				return err
+			}
+		}
+	}
+
+	return fmt.Errorf("%w: %s", ErrSelectorNotFound, ref)
+}
+
+func skipJSON(dec *json.Decoder) error {
+	var value json.RawMessage
+	if err := dec.Decode(&value); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSelector, err)
+	}
+
+	return nil
+}
+
+// locateYAML finds the scalar at a dotted path like "version" or ".image.tag".
+func locateYAML(content []byte, path string) (int, int, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil && !errors.Is(err, io.EOF) {
+		return 0, 0, fmt.Errorf("%w: %w", ErrInvalidSelector, err)
+	}
+
+	if len(doc.Content) == 0 {
+		return 0, 0, fmt.Errorf("%w: %s", ErrSelectorNotFound, path)
+	}
+
+	node := doc.Content[0]
+
+	for _, key := range strings.Split(strings.TrimPrefix(path, "."), ".") {
+		node = yamlChild(node, key)
+		if node == nil {
+			return 0, 0, fmt.Errorf("%w: %s", ErrSelectorNotFound, path)
+		}
+	}
+
+	if node.Kind != yaml.ScalarNode {
+		return 0, 0, fmt.Errorf("%w: %s is not a scalar", ErrUnsupportedValue, path)
+	}
+
+	start := lineColumnOffset(content, node.Line, node.Column)
+	if node.Style&(yaml.DoubleQuotedStyle|yaml.SingleQuotedStyle) != 0 {
+		start++
+	}
+
+	end := start + len(node.Value)
+	if end > len(content) || string(content[start:end]) != node.Value {
+		return 0, 0, fmt.Errorf("%w: %s is not a single-line scalar", ErrUnsupportedValue, path)
+	}
+
+	return start, end, nil
+}
+
+func yamlChild(node *yaml.Node, key string) *yaml.Node {
+	switch node.Kind { //nolint:exhaustive
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == key {
+				return node.Content[i+1]
+			}
+		}
+	case yaml.SequenceNode:
+		if i, err := strconv.Atoi(key); err == nil && i >= 0 && i < len(node.Content) {
+			return node.Content[i]
+		}
+	}
+
+	return nil
+}
+
+// lineColumnOffset converts a 1-based line and rune column into a byte offset.
+func lineColumnOffset(content []byte, line, column int) int {
+	offset := 0
+	for ; line > 1; line-- {
+		next := bytes.IndexByte(content[offset:], '\n')
+		if next < 0 {
+			return len(content)
+		}
+
+		offset += next + 1
+	}
+
+	for ; column > 1 && offset < len(content); column-- {
+		_, size := utf8.DecodeRune(content[offset:])
+		offset += size
+	}
+
+	return offset
+}
+
+// locateTOML finds the string value of a dotted key like "package.version". Only single-line
+// basic strings without escape sequences and literal strings are supported.
+func locateTOML(content []byte, key string) (int, int, error) {
+	table := ""
+	offset := 0
+
+	for _, line := range strings.SplitAfter(string(content), "\n") {
+		lineStart := offset
+		offset += len(line)
+
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, "[") {
+			header, _, _ := strings.Cut(trimmed, "#")
+			table = normalizeTOMLKey(strings.Trim(strings.TrimSpace(header), "[]"))
+
+			continue
+		}
+
+		name, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+
+		fullKey := normalizeTOMLKey(name)
+		if table != "" {
+			fullKey = table + "." + fullKey
+		}
+
+		if fullKey != key {
+			continue
+		}
+
+		valueStart := lineStart + len(name) + 1 + len(value) - len(strings.TrimLeft(value, " \t"))
+		rest := strings.TrimLeft(value, " \t")
+
+		if rest == "" || (rest[0] != '"' && rest[0] != '\'') || strings.HasPrefix(rest, `"""`) || strings.HasPrefix(rest, "'''") {
+			return 0, 0, fmt.Errorf("%w: %s is not a single-line string", ErrUnsupportedValue, key)
+		}
+
+		end := strings.IndexByte(rest[1:], rest[0])
+		if end < 0 {
+			return 0, 0, fmt.Errorf("%w: %s is not a single-line string", ErrUnsupportedValue, key)
+		}
+
+		if rest[0] == '"' && strings.Contains(rest[1:1+end], `\`) {
+			return 0, 0, fmt.Errorf("%w: %s contains escape sequences", ErrUnsupportedValue, key)
+		}
+
+		return valueStart + 1, valueStart + 1 + end, nil
+	}
+
+	return 0, 0, fmt.Errorf("%w: %s", ErrSelectorNotFound, key)
+}
+
+// normalizeTOMLKey removes whitespace and quotes around the parts of a dotted key.
+func normalizeTOMLKey(key string) string {
+	parts := strings.Split(strings.TrimSpace(key), ".")
+	for i, part := range parts {
+		parts[i] = strings.Trim(strings.TrimSpace(part), `"'`)
+	}
+
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,156 @@
+package gosemver_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+func TestReplaceVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		selector string
+		content  string
+		want     string
+		wantErr  error
+	}{
+		{
+			"plain file", "VERSION", "",
+			"1.2.3\n",
+			"2.0.0\n", nil,
+		},
+		{
+			"package.json", "package.json", "",
+			"{\n  \"dependencies\": {\"version\": \"9.9.9\"},\n  \"version\": \"1.2.3\"\n}\n",
+			"{\n  \"dependencies\": {\"version\": \"9.9.9\"},\n  \"version\": \"2.0.0\"\n}\n", nil,
+		},
+		{
+			"JSON pointer", "manifest.json", "/packages/1/version",
+			`{"packages": [{"version": "0.1.0"}, {"version": "1.2.3"}]}`,
+			`{"packages": [{"version": "0.1.0"}, {"version": "2.0.0"}]}`, nil,
+		},
+		{
+			"JSON pointer escaping", "manifest.json", "/a~1b",
+			`{"a/b": "1.2.3"}`,
+			`{"a/b": "2.0.0"}`, nil,
+		},
+		{
+			"Chart.yaml", "Chart.yaml", "",
+			"# chart\nversion: 1.2.3 # keep\nappVersion: \"1.0\"\n",
+			"# chart\nversion: 2.0.0 # keep\nappVersion: \"1.0\"\n", nil,
+		},
+		{
+			"YAML quoted nested", "values.yaml", ".image.tag",
+			"image:\n  repo: app\n  tag: 'v1.2.3'\n",
+			"image:\n  repo: app\n  tag: '2.0.0'\n", nil,
+		},
+		{
+			"Cargo.toml", "Cargo.toml", "",
+			"[workspace]\nversion = \"0.0.0\"\n\n[package]\nname = \"x\"\nversion = \"1.2.3\" # keep\n",
+			"[workspace]\nversion = \"0.0.0\"\n\n[package]\nname = \"x\"\nversion = \"2.0.0\" # keep\n", nil,
+		},
+		{
+			"pyproject.toml dotted key", "pyproject.toml", "",
+			"project.name = 'x'\nproject.version = '1.2.3'\n",
+			"project.name = 'x'\nproject.version = '2.0.0'\n", nil,
+		},
+		{
+			"Go source", "version.go", "",
+			"package x\n\nconst Version = \"1.2.3\"\n",
+			"package x\n\nconst Version = \"2.0.0\"\n", nil,
+		},
+		{
+			"regex", "Makefile", `regex:VERSION \?= (\S+)`,
+			"VERSION ?= 1.2.3\n",
+			"VERSION ?= 2.0.0\n", nil,
+		},
+		{"regex without group", "Makefile", `regex:VERSION`, "VERSION", "", gosemver.ErrInvalidSelector},
+		{"regex not found", "Makefile", `regex:VERSION=(.*)`, "", "", gosemver.ErrSelectorNotFound},
+		{"JSON not found", "package.json", "", `{"name": "x"}`, "", gosemver.ErrSelectorNotFound},
+		{"JSON not a string", "package.json", "", `{"version": 1}`, "", gosemver.ErrUnsupportedValue},
+		{"JSON invalid pointer", "package.json", "version", `{"version": "1.2.3"}`, "", gosemver.ErrInvalidSelector},
+		{"YAML not a scalar", "Chart.yaml", "", "version:\n  - 1.2.3\n", "", gosemver.ErrUnsupportedValue},
+		{"TOML not found", "Cargo.toml", "", "[package]\nname = \"x\"\n", "", gosemver.ErrSelectorNotFound},
+		{"TOML not a string", "Cargo.toml", "", "[package]\nversion = 1\n", "", gosemver.ErrUnsupportedValue},
+		{"TOML escape sequence", "Cargo.toml", "", "[package]\nversion = \"1.2.3-\\u0061\"\n", "", gosemver.ErrUnsupportedValue},
+		{"TOML escaped quote", "Cargo.toml", "", "[package]\nversion = \"1.2.3\\\"x\"\n", "", gosemver.ErrUnsupportedValue},
+		{
+			"TOML literal string with backslash", "Cargo.toml", "package.version",
+			"[package]\nversion = '1.2.3'\npath = 'C:\\x'\n",
+			"[package]\nversion = '2.0.0'\npath = 'C:\\x'\n", nil,
+		},
+		{"selector for unknown type", "VERSION", "version", "1.2.3", "", gosemver.ErrInvalidSelector},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gosemver.ReplaceVersion(tt.path, []byte(tt.content), tt.selector, "2.0.0")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ReplaceVersion() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if string(got) != tt.want {
+				t.Errorf("ReplaceVersion() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseVersionFile(t *testing.T) {
+	tests := []struct {
+		spec         string
+		wantPath     string
+		wantSelector string
+	}{
+		{"VERSION", "VERSION", ""},
+		{"package.json:/version", "package.json", "/version"},
+		{"Cargo.toml:package.version", "Cargo.toml", "package.version"},
+		{"deploy/Chart.yaml:.image.tag", "deploy/Chart.yaml", ".image.tag"},
+		{`main.go:regex:Version = "(.+)"`, "main.go", `regex:Version = "(.+)"`},
+		{`Makefile:regex:VERSION:\s*(\S+)`, "Makefile", `regex:VERSION:\s*(\S+)`},
+		{`C:\proj\go.mod:package.version`, `C:\proj\go.mod`, "package.version"},
+		{`C:\proj\VERSION`, `C:\proj\VERSION`, ""},
+		{"C:/proj/package.json:/version", "C:/proj/package.json", "/version"},
+		{"C:/proj/VERSION", "C:/proj/VERSION", ""},
+		{`C:\proj\main.go:regex:v(\S+)`, `C:\proj\main.go`, `regex:v(\S+)`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			got := gosemver.ParseVersionFile(tt.spec)
+			if got.Path != tt.wantPath || got.Selector != tt.wantSelector {
+				t.Errorf("ParseVersionFile() = %+v, want path %q and selector %q", got, tt.wantPath, tt.wantSelector)
+			}
+		})
+	}
+}
+
+func TestVersionFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "package.json")
+	if err := os.WriteFile(path, []byte(`{"version": "1.2.3"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	file := gosemver.ParseVersionFile(path + ":/version")
+	if file.Path != path || file.Selector != "/version" {
+		t.Fatalf("ParseVersionFile() = %+v", file)
+	}
+
+	if err := file.Write("1.3.0"); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	got, err := file.Read()
+	if err != nil || got != "1.3.0" {
+		t.Errorf("Read() = %q, %v, want 1.3.0", got, err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil || info.Mode().Perm() != 0o600 {
+		t.Errorf("Write() did not preserve the file mode: %v, %v", info.Mode(), err)
+	}
+}
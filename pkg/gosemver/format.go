@@ -0,0 +1,66 @@
+package gosemver
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+var ErrInvalidTemplate = errors.New("invalid version template")
+
+// FormatFuncs are the helper functions available in version templates:
+//
+//	pad N VALUE          left-pads VALUE with zeroes to N characters: {{pad 3 .Minor}} => 002
+//	replace OLD NEW S    replaces all OLD in S with NEW: {{.String | replace "." "_"}} => 1_2_3
+//	join SEP VALUES...   joins VALUES with SEP: {{join "." .Major .Minor .Patch 0}} => 1.2.3.0
+//	truncate N S         keeps the first N characters of S
+//	sanitizeDockerTag S  makes S a valid OCI image tag, e.g. replaces '+' with '-'
+var FormatFuncs = template.FuncMap{
+	"pad":               pad,
+	"replace":           replace,
+	"join":              join,
+	"truncate":          truncate,
+	"sanitizeDockerTag": SanitizeDockerTag,
+}
+
+// FormatSemVer renders a Go template against a version, with FormatFuncs available.
+func FormatSemVer(text string, ver *SemVer) (string, error) {
+	tmpl, err := template.New("version").Funcs(FormatFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidTemplate, err)
+	}
+
+	var out strings.Builder
+	if err := tmpl.Execute(&out, ver); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidTemplate, err)
+	}
+
+	return out.String(), nil
+}
+
+func pad(width int, value any) string {
+	return fmt.Sprintf("%0*s", width, fmt.Sprint(value))
+}
+
+func replace(old, replacement, s string) string {
+	return strings.ReplaceAll(s, old, replacement)
+}
+
+func join(sep string, values ...any) string {
+	parts := make([]string, 0, len(values))
+	for _, value := range values {
+		parts = append(parts, fmt.Sprint(value))
+	}
+
+	return strings.Join(parts, sep)
+}
+
+func truncate(n int, s string) string {
+	runes := []rune(s)
+	if n < 0 || len(runes) <= n {
+		return s
+	}
+
+	return string(runes[:n])
+}
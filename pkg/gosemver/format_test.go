@@ -0,0 +1,49 @@
+package gosemver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+func TestFormatSemVer(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		version  string
+		want     string
+		wantErr  error
+	}{
+		{"fields", "{{.Major}}.{{.Minor}}.x", "1.2.3", "1.2.x", nil},
+		{"string", "v{{.String}}", "1.2.3-rc.1", "v1.2.3-rc.1", nil},
+		{"windows file version", `{{join "." .Major .Minor .Patch 0}}`, "1.2.3-rc.1", "1.2.3.0", nil},
+		{"pad", "{{pad 3 .Minor}}", "1.2.3", "002", nil},
+		{"pad shorter than value", "{{pad 1 .Minor}}", "1.20.3", "20", nil},
+		{"replace", `{{.Release | replace "." "_"}}`, "1.2.3", "1_2_3", nil},
+		{"truncate", "{{truncate 3 .Prerelease}}", "1.2.3-alpha", "alp", nil},
+		{"truncate longer than value", "{{truncate 10 .Prerelease}}", "1.2.3-rc", "rc", nil},
+		{"truncate multibyte", `{{.Prerelease | replace "a" "ä" | truncate 2}}`, "1.2.3-alpha", "äl", nil},
+		{"docker tag", "{{.String | sanitizeDockerTag}}", "1.2.3+build.5", "1.2.3-build.5", nil},
+		{"unknown field", "{{.Nope}}", "1.2.3", "", gosemver.ErrInvalidTemplate},
+		{"unknown function", "{{nope .Major}}", "1.2.3", "", gosemver.ErrInvalidTemplate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ver, err := gosemver.ParseSemVer(tt.version)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := gosemver.FormatSemVer(tt.template, ver)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FormatSemVer() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("FormatSemVer() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
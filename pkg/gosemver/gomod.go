@@ -0,0 +1,85 @@
+package gosemver
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrNoModuleDirective   = errors.New("no module directive found in go.mod")
+	ErrModuleMajorMismatch = errors.New("module path does not match the major version")
+
+	moduleMajorSuffix = regexp.MustCompile(`(?:/v|^gopkg\.in/.+\.v)([1-9][0-9]*)(?:-unstable)?$`)
+)
+
+// ModulePath extracts the module path from the content of a go.mod file.
+func ModulePath(gomod string) (string, error) {
+	for _, line := range strings.Split(gomod, "\n") {
+		line, _, _ = strings.Cut(line, "//")
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 || fields[0] != "module" { //nolint:mnd
+			continue
+		}
+
+		path := fields[1]
+		if unquoted, err := strconv.Unquote(path); err == nil {
+			path = unquoted
+		}
+
+		return path, nil
+	}
+
+	return "", ErrNoModuleDirective
+}
+
+// ModuleMajor returns the major version implied by a module path: N for paths ending with "/vN"
+// (or ".vN" for gopkg.in), otherwise 0, which allows both v0 and v1 versions.
+func ModuleMajor(modulePath string) int {
+	matches := moduleMajorSuffix.FindStringSubmatch(modulePath)
+	if matches == nil {
+		return 0
+	}
+
+	major, _ := strconv.Atoi(matches[1])
+
+	return major
+}
+
+// ModulePathForMajor returns the module path a module must use for versions with the given major.
+func ModulePathForMajor(modulePath string, major int) string {
+	if strings.HasPrefix(modulePath, "gopkg.in/") {
+		base := modulePath
+		if loc := moduleMajorSuffix.FindStringSubmatchIndex(modulePath); loc != nil {
+			base = modulePath[:loc[2]-len(".v")]
+		}
+
+		return fmt.Sprintf("%s.v%d", base, max(major, 1))
+	}
+
+	base := modulePath
+	if loc := moduleMajorSuffix.FindStringSubmatchIndex(modulePath); loc != nil {
+		base = modulePath[:loc[0]]
+	}
+
+	if major < 2 { //nolint:mnd
+		return base
+	}
+
+	return fmt.Sprintf("%s/v%d", base, major)
+}
+
+// CheckModuleMajor checks that a version can be released for a module path. Versions with a
+// major of 2 or higher require a matching "/vN" suffix, v0 and v1 versions require none.
+func CheckModuleMajor(modulePath string, ver *SemVer) error {
+	want := ModulePathForMajor(modulePath, ver.Major)
+	if want == modulePath {
+		return nil
+	}
+
+	return fmt.Errorf("%w: version %s requires module path %s, go.mod declares %s",
+		ErrModuleMajorMismatch, ver, want, modulePath)
+}
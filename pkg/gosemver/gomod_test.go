@@ -0,0 +1,77 @@
+package gosemver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+func TestModulePath(t *testing.T) {
+	tests := []struct {
+		name    string
+		gomod   string
+		want    string
+		wantErr error
+	}{
+		{"plain", "module example.com/foo\n\ngo 1.23.0\n", "example.com/foo", nil},
+		{"quoted", "module \"example.com/foo/v2\"\n", "example.com/foo/v2", nil},
+		{"comments", "// Deprecated: use v3\nmodule example.com/foo/v2 // comment\n", "example.com/foo/v2", nil},
+		{"missing", "go 1.23.0\n", "", gosemver.ErrNoModuleDirective},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gosemver.ModulePath(tt.gomod)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ModulePath() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("ModulePath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckModuleMajor(t *testing.T) {
+	tests := []struct {
+		name       string
+		modulePath string
+		version    string
+		wantPath   string
+		wantErr    bool
+	}{
+		{"v0 without suffix", "example.com/foo", "0.3.0", "example.com/foo", false},
+		{"v1 without suffix", "example.com/foo", "1.4.2", "example.com/foo", false},
+		{"v2 without suffix", "example.com/foo", "2.0.0", "example.com/foo/v2", true},
+		{"v2 with suffix", "example.com/foo/v2", "2.1.0-rc.1", "example.com/foo/v2", false},
+		{"v3 with v2 suffix", "example.com/foo/v2", "3.0.0", "example.com/foo/v3", true},
+		{"v1 with v2 suffix", "example.com/foo/v2", "1.0.0", "example.com/foo", true},
+		{"v1 suffix is invalid", "example.com/foo/v1", "1.0.0", "example.com/foo", true},
+		{"gopkg.in", "gopkg.in/yaml.v3", "3.0.1", "gopkg.in/yaml.v3", false},
+		{"gopkg.in mismatch", "gopkg.in/yaml.v3", "4.0.0", "gopkg.in/yaml.v4", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ver, err := gosemver.ParseSemVer(tt.version)
+			if err != nil {
+				t.Fatalf("ParseSemVer() error = %v", err)
+			}
+
+			err = gosemver.CheckModuleMajor(tt.modulePath, ver)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckModuleMajor() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err != nil && !errors.Is(err, gosemver.ErrModuleMajorMismatch) {
+				t.Errorf("CheckModuleMajor() error = %v, want %v", err, gosemver.ErrModuleMajorMismatch)
+			}
+
+			if got := gosemver.ModulePathForMajor(tt.modulePath, ver.Major); got != tt.wantPath {
+				t.Errorf("ModulePathForMajor() = %s, want %s", got, tt.wantPath)
+			}
+		})
+	}
+}
@@ -42,12 +42,12 @@ const (
 
 // SemVer holds the parsed segments of a semantic version.
 type SemVer struct {
-	Major      int    `json:"major"`
-	Minor      int    `json:"minor"`
-	Patch      int    `json:"patch"`
-	Prerelease string `json:"prerelease"`
-	Build      string `json:"build"`
-	Release    string `json:"release"`
+	Major      int    `json:"major" yaml:"major"`
+	Minor      int    `json:"minor" yaml:"minor"`
+	Patch      int    `json:"patch" yaml:"patch"`
+	Prerelease string `json:"prerelease" yaml:"prerelease"`
+	Build      string `json:"build" yaml:"build"`
+	Release    string `json:"release" yaml:"release"`
 }
 
 // String converts a SemVer object to a string.
@@ -115,7 +115,7 @@ func IsBuild(version string) bool {
 
 // CompareSemVer compares two SemVer (ignoring build).
 // Returns -1 if left < right, 0 if equal, 1 if left > right.
-func CompareSemVer(version, otherVersion string) (int, error) { //nolint:gocognit,cyclop,funlen
+func CompareSemVer(version, otherVersion string) (int, error) {
 	left, err := ParseSemVer(version)
 	if err != nil {
 		return 0, err
@@ -126,38 +126,43 @@ func CompareSemVer(version, otherVersion string) (int, error) { //nolint:gocogni
 		return 0, err
 	}
 
+	return compareVersions(left, right), nil
+}
+
+// compareVersions compares two parsed versions by precedence like CompareSemVer.
+func compareVersions(left, right *SemVer) int { //nolint:gocognit,cyclop,funlen
 	// Compare major, minor, patch
 	if left.Major < right.Major {
-		return -1, nil
+		return -1
 	} else if left.Major > right.Major {
-		return 1, nil
+		return 1
 	}
 
 	if left.Minor < right.Minor {
-		return -1, nil
+		return -1
 	} else if left.Minor > right.Minor {
-		return 1, nil
+		return 1
 	}
 
 	if left.Patch < right.Patch {
-		return -1, nil
+		return -1
 	} else if left.Patch > right.Patch {
-		return 1, nil
+		return 1
 	}
 
 	// Compare pre-release
 	// If both empty, they are equal
 	if left.Prerelease == "" && right.Prerelease == "" {
-		return 0, nil
+		return 0
 	}
 
 	// If only one is empty, that one is greater (i.e. a version without prerelease is newer)
 	if left.Prerelease == "" && right.Prerelease != "" {
-		return 1, nil
+		return 1
 	}
 
 	if left.Prerelease != "" && right.Prerelease == "" {
-		return -1, nil
+		return -1
 	}
 
 	// Both are non-empty, compare using semver pre-release rules
@@ -166,11 +171,11 @@ func CompareSemVer(version, otherVersion string) (int, error) { //nolint:gocogni
 
 	for i := 0; i < len(leftFields) || i < len(rightFields); i++ {
 		if i >= len(leftFields) {
-			return -1, nil // left is shorter => less
+			return -1 // left is shorter => less
 		}
 
 		if i >= len(rightFields) {
-			return 1, nil // right is shorter => less
+			return 1 // right is shorter => less
 		}
 
 		lf, rf := leftFields[i], rightFields[i]
@@ -181,27 +186,27 @@ func CompareSemVer(version, otherVersion string) (int, error) { //nolint:gocogni
 		if lErr == nil && rErr == nil { //nolint:gocritic,nestif
 			// Compare numeric
 			if lNum < rNum {
-				return -1, nil
+				return -1
 			} else if lNum > rNum {
-				return 1, nil
+				return 1
 			}
 		} else if lErr == nil && rErr != nil { // else equal, keep going
 			// numeric vs string => numeric < string
-			return -1, nil //nolint:nilerr
+			return -1
 		} else if lErr != nil && rErr == nil {
 			// string vs numeric => string > numeric
-			return 1, nil
+			return 1
 		} else {
 			// both string
 			if lf < rf {
-				return -1, nil
+				return -1
 			} else if lf > rf {
-				return 1, nil
+				return 1
 			}
 		}
 	}
 
-	return 0, nil
+	return 0
 }
 
 // BumpSemVer bumps a version with major/minor/patch/prerelease/build/release logic.
@@ -249,6 +254,8 @@ func BumpSemVer(semverID, version, newPrereleaseID, newBuildID string) (*SemVer,
 		return nil, fmt.Errorf("%w: %s", ErrInvalidCommand, semverID)
 	}
 
+	ver.Release = fmt.Sprintf("%d.%d.%d", ver.Major, ver.Minor, ver.Patch)
+
 	return ver, nil
 }
 
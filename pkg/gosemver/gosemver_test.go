@@ -298,6 +298,10 @@ func TestBumpSemVer(t *testing.T) {
 				got.Prerelease != tt.want.Prerelease || got.Build != tt.want.Build {
 				t.Errorf("BumpSemVer() = %+v, want %+v", got, tt.want)
 			}
+
+			if release := fmt.Sprintf("%d.%d.%d", tt.want.Major, tt.want.Minor, tt.want.Patch); got.Release != release {
+				t.Errorf("BumpSemVer() release = %q, want %q", got.Release, release)
+			}
 		})
 	}
 }
@@ -0,0 +1,178 @@
+package gosemver
+
+import "slices"
+
+// RangeIndex answers which of many constraints a version satisfies in logarithmic time in the number
+// of constraints, plus the number of matches. The intervals of all constraints are kept in a centered
+// interval tree: every node holds the intervals spanning its center version, sorted by both bounds,
+// and passes the intervals wholly below or above the center to its children.
+type RangeIndex struct {
+	constraints []*Constraint
+	root        *indexNode
+}
+
+type indexEntry struct {
+	interval   Interval
+	constraint int
+}
+
+type indexNode struct {
+	center *SemVer
+	// byLower holds the entries spanning the center by ascending lower bound.
+	byLower []indexEntry
+	// byUpper holds the entries spanning the center by descending upper bound.
+	byUpper     []indexEntry
+	left, right *indexNode
+}
+
+// NewRangeIndex indexes the constraints, which match by their position in the slice.
+func NewRangeIndex(constraints []*Constraint) *RangeIndex {
+	var entries []indexEntry
+
+	for i, c := range constraints {
+		for _, interval := range c.IntervalSet() {
+			entries = append(entries, indexEntry{interval: interval, constraint: i})
+		}
+	}
+
+	return &RangeIndex{constraints: constraints, root: newIndexNode(entries)}
+}
+
+// Match returns the positions of the constraints satisfied by ver in ascending order.
+func (idx *RangeIndex) Match(ver *SemVer) []int {
+	var matched []int
+
+	for node := idx.root; node != nil; {
+		cmp := compareVersions(ver, node.center)
+
+		switch {
+		case cmp < 0:
+			// Every entry spans the center above ver, so it matches once its lower bound admits ver.
+			for _, entry := range node.byLower {
+				if !(Interval{Lower: entry.interval.Lower}).Contains(ver) {
+					break
+				}
+				matched = idx.appendMatch(matched, entry, ver)
+			}
+			node = node.left
+		case cmp > 0:
+			for _, entry := range node.byUpper {
+				if !(Interval{Upper: entry.interval.Upper}).Contains(ver) {
+					break
+				}
+				matched = idx.appendMatch(matched, entry, ver)
+			}
+			node = node.right
+		default:
+			for _, entry := range node.byLower {
+				if entry.interval.Contains(ver) {
+					matched = idx.appendMatch(matched, entry, ver)
+				}
+			}
+			node = nil
+		}
+	}
+
+	slices.Sort(matched)
+
+	return matched
+}
+
+// Len returns the number of indexed constraints.
+func (idx *RangeIndex) Len() int {
+	return len(idx.constraints)
+}
+
+// appendMatch appends the constraint of an entry containing ver, unless its prerelease rules exclude
+// ver.
+func (idx *RangeIndex) appendMatch(matched []int, entry indexEntry, ver *SemVer) []int {
+	c := idx.constraints[entry.constraint]
+	if c.IncludePrerelease || ver.Prerelease == "" || c.Check(ver) {
+		matched = append(matched, entry.constraint)
+	}
+
+	return matched
+}
+
+// newIndexNode builds the subtree for entries, centered on the median of their bound versions. The
+// entries having a bound at the center span it, so every level holds at least one entry.
+func newIndexNode(entries []indexEntry) *indexNode {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	node := &indexNode{center: medianBound(entries)}
+
+	var left, right []indexEntry
+
+	for _, entry := range entries {
+		switch lower, upper := entry.interval.Lower.Version, entry.interval.Upper.Version; {
+		case upper != nil && compareVersions(upper, node.center) < 0:
+			left = append(left, entry)
+		case lower != nil && compareVersions(lower, node.center) > 0:
+			right = append(right, entry)
+		default:
+			node.byLower = append(node.byLower, entry)
+		}
+	}
+
+	node.byUpper = slices.Clone(node.byLower)
+	slices.SortStableFunc(node.byLower, func(a, b indexEntry) int {
+		return compareLower(a.interval.Lower, b.interval.Lower)
+	})
+	slices.SortStableFunc(node.byUpper, func(a, b indexEntry) int {
+		return compareUpper(b.interval.Upper, a.interval.Upper)
+	})
+
+	node.left = newIndexNode(left)
+	node.right = newIndexNode(right)
+
+	return node
+}
+
+// medianBound returns the median of the bound versions of the entries, or the lowest version if all
+// of them are unbounded.
+func medianBound(entries []indexEntry) *SemVer {
+	versions := make([]*SemVer, 0, 2*len(entries)) //nolint:mnd
+
+	for _, entry := range entries {
+		for _, bound := range []Bound{entry.interval.Lower, entry.interval.Upper} {
+			if bound.Version != nil {
+				versions = append(versions, bound.Version)
+			}
+		}
+	}
+
+	if len(versions) == 0 {
+		return lowestVersion
+	}
+
+	slices.SortFunc(versions, compareVersions)
+
+	return versions[len(versions)/2]
+}
+
+// compareUpper orders upper bounds by the last version they admit.
+func compareUpper(a, b Bound) int {
+	switch {
+	case a.Version == nil && b.Version == nil:
+		return 0
+	case a.Version == nil:
+		return 1
+	case b.Version == nil:
+		return -1
+	}
+
+	if cmp := compareVersions(a.Version, b.Version); cmp != 0 {
+		return cmp
+	}
+
+	switch {
+	case a.Inclusive == b.Inclusive:
+		return 0
+	case a.Inclusive:
+		return 1
+	default:
+		return -1
+	}
+}
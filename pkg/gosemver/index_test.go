@@ -0,0 +1,179 @@
+package gosemver_test
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+func TestRangeIndexMatch(t *testing.T) {
+	constraints := []string{
+		">=1.0.0 <1.4.2",
+		"^1.2.0",
+		"<0.9.0 || >=2.0.0 <2.3.1",
+		"*",
+		"1.2.3-rc.1 - 1.2.5",
+		">2.2.0",
+		">2.0.0 <1.0.0",
+	}
+
+	tests := []struct {
+		version string
+		want    []int
+	}{
+		{"0.1.0", []int{2, 3}},
+		{"1.0.0", []int{0, 3}},
+		{"1.2.3", []int{0, 1, 3, 4}},
+		{"1.2.3-rc.2", []int{4}},
+		{"1.4.2", []int{1, 3}},
+		{"2.2.5", []int{2, 3, 5}},
+		{"2.3.1", []int{3, 5}},
+		{"3.0.0-beta.1", nil},
+	}
+
+	parsed := make([]*gosemver.Constraint, 0, len(constraints))
+	for _, constraint := range constraints {
+		c, err := gosemver.ParseConstraint(constraint)
+		if err != nil {
+			t.Fatal(err)
+		}
+		parsed = append(parsed, c)
+	}
+
+	idx := gosemver.NewRangeIndex(parsed)
+	if idx.Len() != len(constraints) {
+		t.Errorf("Len() = %d, want %d", idx.Len(), len(constraints))
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			ver, err := gosemver.ParseSemVer(tt.version)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := idx.Match(ver); !slices.Equal(got, tt.want) {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRangeIndexEmpty(t *testing.T) {
+	idx := gosemver.NewRangeIndex(nil)
+
+	ver, err := gosemver.ParseSemVer("1.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := idx.Match(ver); got != nil {
+		t.Errorf("Match() = %v, want nil", got)
+	}
+}
+
+func TestRangeIndexMatchesNaive(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2)) //nolint:gosec
+	constraints := randomConstraints(rng, 500)
+	idx := gosemver.NewRangeIndex(constraints)
+
+	for range 2000 {
+		ver := randomVersion(rng)
+		if got, want := idx.Match(ver), naiveMatch(constraints, ver); !slices.Equal(got, want) {
+			t.Fatalf("Match(%s) = %v, want %v", ver, got, want)
+		}
+	}
+}
+
+func BenchmarkRangeIndexMatch(b *testing.B) {
+	for _, n := range []int{100, 10000} {
+		rng := rand.New(rand.NewPCG(1, 2)) //nolint:gosec
+		constraints := randomConstraints(rng, n)
+		versions := make([]*gosemver.SemVer, 1000)
+		for i := range versions {
+			versions[i] = randomVersion(rng)
+		}
+
+		b.Run(fmt.Sprintf("index/%d", n), func(b *testing.B) {
+			idx := gosemver.NewRangeIndex(constraints)
+			b.ResetTimer()
+
+			for i := range b.N {
+				idx.Match(versions[i%len(versions)])
+			}
+		})
+
+		b.Run(fmt.Sprintf("naive/%d", n), func(b *testing.B) {
+			for i := range b.N {
+				naiveMatch(constraints, versions[i%len(versions)])
+			}
+		})
+	}
+}
+
+func BenchmarkNewRangeIndex(b *testing.B) {
+	constraints := randomConstraints(rand.New(rand.NewPCG(1, 2)), 10000) //nolint:gosec
+
+	for range b.N {
+		gosemver.NewRangeIndex(constraints)
+	}
+}
+
+func naiveMatch(constraints []*gosemver.Constraint, ver *gosemver.SemVer) []int {
+	var matched []int
+
+	for i, c := range constraints {
+		if c.Check(ver) {
+			matched = append(matched, i)
+		}
+	}
+
+	return matched
+}
+
+// randomConstraints returns constraints shaped like vulnerability ranges: mostly short spans of
+// patch releases, a few open-ended and some with alternatives.
+func randomConstraints(rng *rand.Rand, n int) []*gosemver.Constraint {
+	constraints := make([]*gosemver.Constraint, 0, n)
+
+	for len(constraints) < n {
+		lower := randomVersion(rng)
+
+		var expr string
+		switch rng.IntN(100) {
+		case 0:
+			expr = fmt.Sprintf("<%s", lower)
+		case 1:
+			expr = fmt.Sprintf(">=%s", lower)
+		case 2, 3, 4, 5, 6:
+			expr = fmt.Sprintf("^%s || ~%s", lower, randomVersion(rng))
+		default:
+			expr = fmt.Sprintf(">=%s <%d.%d.%d", lower, lower.Major, lower.Minor, lower.Patch+1+rng.IntN(5))
+		}
+
+		c, err := gosemver.ParseConstraint(expr)
+		if err != nil {
+			panic(err)
+		}
+		constraints = append(constraints, c)
+	}
+
+	return constraints
+}
+
+func randomVersion(rng *rand.Rand) *gosemver.SemVer {
+	version := fmt.Sprintf("%d.%d.%d", rng.IntN(50), rng.IntN(20), rng.IntN(20))
+	if rng.IntN(5) == 0 {
+		version += fmt.Sprintf("-rc.%d", rng.IntN(3))
+	}
+
+	ver, err := gosemver.ParseSemVer(version)
+	if err != nil {
+		panic(err)
+	}
+
+	return ver
+}
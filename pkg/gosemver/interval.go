@@ -0,0 +1,170 @@
+package gosemver
+
+// Bound is an end of an Interval, a nil Version means the interval is unbounded on that side.
+type Bound struct {
+	Version   *SemVer
+	Inclusive bool
+}
+
+// Interval is the set of versions between two bounds by precedence.
+type Interval struct {
+	Lower Bound
+	Upper Bound
+}
+
+// lowestVersion precedes all other versions.
+var lowestVersion = newSemVer(0, 0, 0, "0")
+
+// Intervals returns the intervals of versions satisfying the constraint, one per range, with empty
+// ranges left out.
+func (c *Constraint) Intervals() []Interval {
+	intervals := make([]Interval, 0, len(c.Ranges))
+
+	for _, r := range c.Ranges {
+		if interval := r.Interval(); !interval.IsEmpty() {
+			intervals = append(intervals, interval)
+		}
+	}
+
+	return intervals
+}
+
+// Interval returns the interval of versions satisfying all comparators of the range.
+func (r Range) Interval() Interval {
+	var interval Interval
+
+	for _, comparator := range r {
+		switch comparator.Op {
+		case OpEqual:
+			interval.Lower = maxLower(interval.Lower, Bound{Version: comparator.Version, Inclusive: true})
+			interval.Upper = minUpper(interval.Upper, Bound{Version: comparator.Version, Inclusive: true})
+		case OpGreater, OpGreaterOrEqual:
+			interval.Lower = maxLower(interval.Lower,
+				Bound{Version: comparator.Version, Inclusive: comparator.Op == OpGreaterOrEqual})
+		case OpLess, OpLessOrEqual:
+			interval.Upper = minUpper(interval.Upper,
+				Bound{Version: comparator.Version, Inclusive: comparator.Op == OpLessOrEqual})
+		}
+	}
+
+	return interval
+}
+
+// IsEmpty reports whether no version lies in the interval. An exclusive lower bound admits the
+// successor of its version first, so '>1.2.3 <1.2.4-0' is empty.
+func (i Interval) IsEmpty() bool {
+	if i.Upper.Version == nil {
+		return false
+	}
+
+	lower := lowestVersion
+	if i.Lower.Version != nil {
+		lower = i.Lower.Version
+		if !i.Lower.Inclusive {
+			lower = successor(lower)
+		}
+	}
+
+	cmp := compareVersions(lower, i.Upper.Version)
+
+	return cmp > 0 || (cmp == 0 && !i.Upper.Inclusive)
+}
+
+// Contains reports whether a version lies in the interval.
+func (i Interval) Contains(ver *SemVer) bool {
+	if i.Lower.Version != nil {
+		cmp := compareVersions(ver, i.Lower.Version)
+		if cmp < 0 || (cmp == 0 && !i.Lower.Inclusive) {
+			return false
+		}
+	}
+
+	if i.Upper.Version != nil {
+		cmp := compareVersions(ver, i.Upper.Version)
+		if cmp > 0 || (cmp == 0 && !i.Upper.Inclusive) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Range returns the comparators describing the interval.
+func (i Interval) Range() Range {
+	if i.Lower.Version != nil && i.Upper.Version != nil && i.Lower.Inclusive && i.Upper.Inclusive &&
+		compareVersions(i.Lower.Version, i.Upper.Version) == 0 {
+		return Range{{Op: OpEqual, Version: i.Lower.Version}}
+	}
+
+	r := Range{}
+
+	if i.Lower.Version != nil {
+		op := OpGreater
+		if i.Lower.Inclusive {
+			op = OpGreaterOrEqual
+		}
+
+		r = append(r, Comparator{Op: op, Version: i.Lower.Version})
+	}
+
+	if i.Upper.Version != nil {
+		op := OpLess
+		if i.Upper.Inclusive {
+			op = OpLessOrEqual
+		}
+
+		r = append(r, Comparator{Op: op, Version: i.Upper.Version})
+	}
+
+	return r
+}
+
+// NewConstraint returns a constraint satisfied by the versions in any of the intervals, prereleases
+// included.
+func NewConstraint(intervals []Interval) *Constraint {
+	c := &Constraint{IncludePrerelease: true}
+
+	for _, interval := range intervals {
+		if !interval.IsEmpty() {
+			c.Ranges = append(c.Ranges, interval.Range())
+		}
+	}
+
+	return c
+}
+
+// maxLower returns the more restrictive of two lower bounds.
+func maxLower(a, b Bound) Bound {
+	if a.Version == nil {
+		return b
+	}
+
+	if b.Version == nil {
+		return a
+	}
+
+	cmp := compareVersions(a.Version, b.Version)
+	if cmp > 0 || (cmp == 0 && !a.Inclusive) {
+		return a
+	}
+
+	return b
+}
+
+// minUpper returns the more restrictive of two upper bounds.
+func minUpper(a, b Bound) Bound {
+	if a.Version == nil {
+		return b
+	}
+
+	if b.Version == nil {
+		return a
+	}
+
+	cmp := compareVersions(a.Version, b.Version)
+	if cmp < 0 || (cmp == 0 && !a.Inclusive) {
+		return a
+	}
+
+	return b
+}
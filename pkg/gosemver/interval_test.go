@@ -0,0 +1,62 @@
+package gosemver_test
+
+import (
+	"testing"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+func TestIntervals(t *testing.T) {
+	tests := []struct {
+		name       string
+		constraint string
+		version    string
+		wantCount  int
+		want       bool
+	}{
+		{"inside", ">=1.0.0 <2.0.0", "1.5.0", 1, true},
+		{"exclusive upper", ">=1.0.0 <2.0.0", "2.0.0", 1, false},
+		{"inclusive upper", ">=1.0.0 <=2.0.0", "2.0.0", 1, true},
+		{"exclusive lower", ">1.0.0", "1.0.0", 1, false},
+		{"exact", "=1.2.3", "1.2.3", 1, true},
+		{"unbounded", "*", "0.0.0-0", 1, true},
+		{"empty range left out", ">2.0.0 <1.0.0 || 1.x", "1.2.0", 1, true},
+		{"empty point", ">1.0.0 <=1.0.0", "1.0.0", 0, false},
+		{"below lowest", "<0.0.0-0", "0.0.0-0", 0, false},
+		{"nothing between bounds", ">1.2.3 <1.2.4-0", "1.2.4-0", 0, false},
+		{"after exclusive prerelease", ">1.2.3-rc.1 <1.2.3-rc.1.0", "1.2.3-rc.1.0", 0, false},
+		{"successor of exclusive lower", ">1.2.3 <=1.2.4-0", "1.2.4-0", 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := gosemver.ParseConstraint(tt.constraint)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ver, err := gosemver.ParseSemVer(tt.version)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			intervals := c.Intervals()
+			if len(intervals) != tt.wantCount {
+				t.Fatalf("Intervals() returned %d intervals, want %d", len(intervals), tt.wantCount)
+			}
+
+			got := false
+			for _, interval := range intervals {
+				got = got || interval.Contains(ver)
+			}
+
+			if got != tt.want {
+				t.Errorf("Contains(%s) = %v, want %v", tt.version, got, tt.want)
+			}
+
+			if check := gosemver.NewConstraint(intervals).Check(ver); check != tt.want {
+				t.Errorf("NewConstraint().Check(%s) = %v, want %v", tt.version, check, tt.want)
+			}
+		})
+	}
+}
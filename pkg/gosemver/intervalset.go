@@ -0,0 +1,108 @@
+package gosemver
+
+import "slices"
+
+// IntervalSet is a union of disjoint, non-adjacent intervals sorted by precedence.
+type IntervalSet []Interval
+
+// NewIntervalSet returns the union of intervals, leaving out empty ones and merging overlapping and
+// adjacent ones.
+func NewIntervalSet(intervals ...Interval) IntervalSet {
+	sorted := make([]Interval, 0, len(intervals))
+	for _, interval := range intervals {
+		if !interval.IsEmpty() {
+			sorted = append(sorted, interval)
+		}
+	}
+
+	slices.SortStableFunc(sorted, func(a, b Interval) int { return compareLower(a.Lower, b.Lower) })
+
+	set := IntervalSet{}
+
+	for _, interval := range sorted {
+		if len(set) > 0 && touches(set[len(set)-1], interval) {
+			set[len(set)-1].Upper = maxUpper(set[len(set)-1].Upper, interval.Upper)
+
+			continue
+		}
+
+		set = append(set, interval)
+	}
+
+	return set
+}
+
+// IntervalSet returns the set of versions satisfying the constraint by precedence.
+func (c *Constraint) IntervalSet() IntervalSet {
+	return NewIntervalSet(c.Intervals()...)
+}
+
+// Contains reports whether a version lies in one of the intervals.
+func (s IntervalSet) Contains(ver *SemVer) bool {
+	for _, interval := range s {
+		if interval.Contains(ver) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Constraint returns a constraint satisfied by the versions in the set, prereleases included.
+func (s IntervalSet) Constraint() *Constraint {
+	return NewConstraint(s)
+}
+
+// touches reports whether b, which does not start before a, overlaps a or starts right where a ends.
+func touches(a, b Interval) bool {
+	if a.Upper.Version == nil || b.Lower.Version == nil {
+		return true
+	}
+
+	cmp := compareVersions(b.Lower.Version, a.Upper.Version)
+
+	return cmp < 0 || (cmp == 0 && (a.Upper.Inclusive || b.Lower.Inclusive))
+}
+
+// compareLower orders lower bounds by the first version they admit.
+func compareLower(a, b Bound) int {
+	switch {
+	case a.Version == nil && b.Version == nil:
+		return 0
+	case a.Version == nil:
+		return -1
+	case b.Version == nil:
+		return 1
+	}
+
+	if cmp := compareVersions(a.Version, b.Version); cmp != 0 {
+		return cmp
+	}
+
+	switch {
+	case a.Inclusive == b.Inclusive:
+		return 0
+	case a.Inclusive:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// maxUpper returns the less restrictive of two upper bounds.
+func maxUpper(a, b Bound) Bound {
+	if a.Version == nil {
+		return a
+	}
+
+	if b.Version == nil {
+		return b
+	}
+
+	cmp := compareVersions(a.Version, b.Version)
+	if cmp > 0 || (cmp == 0 && a.Inclusive) {
+		return a
+	}
+
+	return b
+}
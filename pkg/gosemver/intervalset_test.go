@@ -0,0 +1,40 @@
+package gosemver_test
+
+import (
+	"testing"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+func TestNewIntervalSet(t *testing.T) {
+	tests := []struct {
+		name       string
+		constraint string
+		want       string
+	}{
+		{"single", ">=1.0.0 <2.0.0", ">=1.0.0 <2.0.0"},
+		{"sorted", ">=3.0.0 || <1.0.0", "<1.0.0 || >=3.0.0"},
+		{"overlapping", ">=1.0.0 <3.0.0 || >=1.5.0 <2.0.0", ">=1.0.0 <3.0.0"},
+		{"partially overlapping", ">=1.0.0 <2.0.0 || >=1.5.0 <3.0.0", ">=1.0.0 <3.0.0"},
+		{"adjacent", ">=1.0.0 <2.0.0 || >=2.0.0 <3.0.0", ">=1.0.0 <3.0.0"},
+		{"adjacent inclusive upper", ">=1.0.0 <=2.0.0 || >2.0.0 <3.0.0", ">=1.0.0 <3.0.0"},
+		{"gap of one version", ">=1.0.0 <2.0.0 || >2.0.0 <3.0.0", ">=1.0.0 <2.0.0 || >2.0.0 <3.0.0"},
+		{"unbounded", "<1.0.0 || *", "*"},
+		{"exact inside", "1.2.3 || 1.x", ">=1.0.0 <2.0.0-0"},
+		{"empty left out", ">2.0.0 <1.0.0 || 1.2.3", "=1.2.3"},
+		{"empty", ">2.0.0 <1.0.0", "<0.0.0-0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := gosemver.ParseConstraint(tt.constraint)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := c.IntervalSet().Constraint().String(); got != tt.want {
+				t.Errorf("IntervalSet() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
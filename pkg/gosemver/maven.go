@@ -0,0 +1,182 @@
+package gosemver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseMavenRange parses a Maven version range like '[1.2,2.0)' or '(,1.0],[1.2,)'. Brackets include
+// the bound, parentheses exclude it, an empty bound is unbounded and '[1.0]' is exactly 1.0. A version
+// without brackets is a minimum version, as the Maven Enforcer plugin treats soft requirements.
+//
+// Maven versions are coerced to semantic versions: missing minor and patch numbers are zero and a
+// qualifier after '-' becomes the prerelease, so '1.0-SNAPSHOT' is '1.0.0-SNAPSHOT'.
+func ParseMavenRange(constraint string) (*Constraint, error) {
+	spec := strings.ReplaceAll(constraint, " ", "")
+	if spec == "" {
+		return nil, fmt.Errorf("%w: empty Maven range", ErrInvalidConstraint)
+	}
+
+	if !strings.ContainsAny(spec, "[(") {
+		ver, err := parseMavenVersion(spec)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %w", ErrInvalidConstraint, constraint, err)
+		}
+
+		return NewConstraint([]Interval{{Lower: Bound{Version: ver, Inclusive: true}}}), nil
+	}
+
+	var intervals []Interval
+
+	for spec != "" {
+		end := strings.IndexAny(spec, "])")
+		if end < 0 {
+			return nil, fmt.Errorf("%w: %q: unclosed range", ErrInvalidConstraint, constraint)
+		}
+
+		interval, err := parseMavenInterval(spec[:end+1])
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %w", ErrInvalidConstraint, constraint, err)
+		}
+
+		intervals = append(intervals, interval)
+
+		spec = spec[end+1:]
+		if rest, ok := strings.CutPrefix(spec, ","); ok && rest != "" {
+			spec = rest
+		} else if spec != "" {
+			return nil, fmt.Errorf("%w: %q: expected ',' between ranges", ErrInvalidConstraint, constraint)
+		}
+	}
+
+	return NewConstraint(intervals), nil
+}
+
+// FormatMavenRange returns the constraint in Maven version range notation.
+func FormatMavenRange(c *Constraint) string {
+	intervals := c.IntervalSet()
+	if len(intervals) == 0 {
+		return "(," + lowestVersion.String() + ")"
+	}
+
+	ranges := make([]string, 0, len(intervals))
+
+	for _, interval := range intervals {
+		ranges = append(ranges, formatMavenInterval(interval))
+	}
+
+	return strings.Join(ranges, ",")
+}
+
+// parseMavenInterval parses a single bracketed range like '[1.0,2.0)' or '[1.0]'.
+func parseMavenInterval(spec string) (Interval, error) {
+	open, closing := spec[0], spec[len(spec)-1]
+	if open != '[' && open != '(' {
+		return Interval{}, fmt.Errorf("range %q must start with '[' or '('", spec)
+	}
+
+	bounds := strings.Split(spec[1:len(spec)-1], ",")
+
+	switch len(bounds) {
+	case 1:
+		if open != '[' || closing != ']' || bounds[0] == "" {
+			return Interval{}, fmt.Errorf("exact version %q must be written as '[<version>]'", spec)
+		}
+
+		ver, err := parseMavenVersion(bounds[0])
+		if err != nil {
+			return Interval{}, err
+		}
+
+		return Interval{Lower: Bound{Version: ver, Inclusive: true}, Upper: Bound{Version: ver, Inclusive: true}}, nil
+	case 2: //nolint:mnd
+		var (
+			interval Interval
+			err      error
+		)
+
+		if bounds[0] != "" {
+			interval.Lower.Inclusive = open == '['
+			if interval.Lower.Version, err = parseMavenVersion(bounds[0]); err != nil {
+				return Interval{}, err
+			}
+		} else if open != '(' {
+			return Interval{}, fmt.Errorf("unbounded range %q must start with '('", spec)
+		}
+
+		if bounds[1] != "" {
+			interval.Upper.Inclusive = closing == ']'
+			if interval.Upper.Version, err = parseMavenVersion(bounds[1]); err != nil {
+				return Interval{}, err
+			}
+		} else if closing != ')' {
+			return Interval{}, fmt.Errorf("unbounded range %q must end with ')'", spec)
+		}
+
+		return interval, nil
+	default:
+		return Interval{}, fmt.Errorf("range %q must have at most two bounds", spec)
+	}
+}
+
+func formatMavenInterval(interval Interval) string {
+	lower, upper := interval.Lower, interval.Upper
+	if lower.Version != nil && upper.Version != nil && lower.Inclusive && upper.Inclusive &&
+		compareVersions(lower.Version, upper.Version) == 0 {
+		return "[" + lower.Version.String() + "]"
+	}
+
+	var b strings.Builder
+
+	if lower.Version != nil && lower.Inclusive {
+		b.WriteString("[")
+	} else {
+		b.WriteString("(")
+	}
+
+	if lower.Version != nil {
+		b.WriteString(lower.Version.String())
+	}
+
+	b.WriteString(",")
+
+	if upper.Version != nil {
+		b.WriteString(upper.Version.String())
+	}
+
+	if upper.Version != nil && upper.Inclusive {
+		b.WriteString("]")
+	} else {
+		b.WriteString(")")
+	}
+
+	return b.String()
+}
+
+// parseMavenVersion coerces a Maven version like '1', '1.2', '1.2.3' or '1.0-SNAPSHOT' to a semantic version.
+func parseMavenVersion(version string) (*SemVer, error) {
+	numbers, qualifier, _ := strings.Cut(version, "-")
+
+	fields := strings.Split(numbers, ".")
+	if len(fields) > 3 { //nolint:mnd
+		return nil, fmt.Errorf("%w: %s", ErrInvalidVersion, version)
+	}
+
+	var parts [3]int
+
+	for i, field := range fields {
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 0 || field[0] == '+' {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidVersion, version)
+		}
+
+		parts[i] = n
+	}
+
+	if qualifier != "" && !IsPrerelease(qualifier) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidVersion, version)
+	}
+
+	return newSemVer(parts[0], parts[1], parts[2], qualifier), nil
+}
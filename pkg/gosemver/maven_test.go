@@ -0,0 +1,122 @@
+package gosemver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+func TestParseMavenRange(t *testing.T) {
+	tests := []struct {
+		name    string
+		maven   string
+		want    string
+		wantErr error
+	}{
+		{"half open", "[1.2,2.0)", ">=1.2.0 <2.0.0", nil},
+		{"closed", "[1.2,1.3]", ">=1.2.0 <=1.3.0", nil},
+		{"exact", "[1.0]", "=1.0.0", nil},
+		{"upper only", "(,1.0]", "<=1.0.0", nil},
+		{"lower only", "[1.5,)", ">=1.5.0", nil},
+		{"union", "(,1.0],[1.2,)", "<=1.0.0 || >=1.2.0", nil},
+		{"exclusion", "(,1.1),(1.1,)", "<1.1.0 || >1.1.0", nil},
+		{"spaces", "[1.2, 2.0)", ">=1.2.0 <2.0.0", nil},
+		{"qualifier", "[1.0-SNAPSHOT,2)", ">=1.0.0-SNAPSHOT <2.0.0", nil},
+		{"soft requirement", "1.0", ">=1.0.0", nil},
+		{"unbounded", "(,)", "*", nil},
+		{"empty interval", "(1.0,1.0)", "<0.0.0-0", nil},
+		{"exact with parenthesis", "(1.0)", "", gosemver.ErrInvalidConstraint},
+		{"unbounded with bracket", "[,1.0]", "", gosemver.ErrInvalidConstraint},
+		{"unclosed", "[1.0,2.0", "", gosemver.ErrInvalidConstraint},
+		{"missing comma", "[1.0,2.0)[3.0,)", "", gosemver.ErrInvalidConstraint},
+		{"too many bounds", "[1.0,2.0,3.0]", "", gosemver.ErrInvalidConstraint},
+		{"invalid version", "[1.a,2.0)", "", gosemver.ErrInvalidConstraint},
+		{"empty", "", "", gosemver.ErrInvalidConstraint},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gosemver.ParseMavenRange(tt.maven)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseMavenRange() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParseMavenRange() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatMavenRange(t *testing.T) {
+	tests := []struct {
+		name       string
+		constraint string
+		want       string
+	}{
+		{"half open", ">=1.2.0 <2.0.0", "[1.2.0,2.0.0)"},
+		{"exact", "1.2.3", "[1.2.3]"},
+		{"upper only", "<=1.0.0", "(,1.0.0]"},
+		{"lower only", ">1.5.0", "(1.5.0,)"},
+		{"union", "<=1.0.0 || >=1.2.0", "(,1.0.0],[1.2.0,)"},
+		{"wildcard", "1.x", "[1.0.0,2.0.0-0)"},
+		{"any", "*", "(,)"},
+		{"intersected comparators", ">=1.0.0 >=1.2.0 <3.0.0 <=2.0.0", "[1.2.0,2.0.0]"},
+		{"empty", ">2.0.0 <1.0.0", "(,0.0.0-0)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := gosemver.ParseConstraint(tt.constraint)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := gosemver.FormatMavenRange(c); got != tt.want {
+				t.Errorf("FormatMavenRange() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseConstraintSyntax(t *testing.T) {
+	tests := []struct {
+		name       string
+		constraint string
+		syntax     string
+		version    string
+		want       bool
+		wantErr    error
+	}{
+		{"native", ">=1.2.0 <2.0.0", gosemver.SyntaxNative, "1.5.0", true, nil},
+		{"default", ">=1.2.0 <2.0.0", "", "2.0.0", false, nil},
+		{"maven", "[1.2,2.0)", gosemver.SyntaxMaven, "1.5.0", true, nil},
+		{"maven excluded", "(,1.0],[1.2,)", gosemver.SyntaxMaven, "1.1.0", false, nil},
+		{"maven prerelease", "[1.0,)", gosemver.SyntaxMaven, "1.1.0-SNAPSHOT", true, nil},
+		{"maven soft requirement prerelease", "1.0", gosemver.SyntaxMaven, "1.1.0-SNAPSHOT", true, nil},
+		{"unknown", "1.x", "gradle", "", false, gosemver.ErrUnknownSyntax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := gosemver.ParseConstraintSyntax(tt.constraint, tt.syntax)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseConstraintSyntax() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			ver, err := gosemver.ParseSemVer(tt.version)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := c.Check(ver); got != tt.want {
+				t.Errorf("Check(%s) = %v, want %v", tt.version, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package gosemver
+
+import "fmt"
+
+// Kinds of successors which start a prerelease of the next version.
+const (
+	Premajor = "premajor"
+	Preminor = "preminor"
+	Prepatch = "prepatch"
+)
+
+// Successor is a possible next version.
+type Successor struct {
+	// Kind is major, minor, patch, premajor, preminor, prepatch, prerelease or release.
+	Kind    string
+	Version *SemVer
+}
+
+// NextVersions returns the successors of a version: the next patch, minor and major releases, the
+// first prereleases of them with prereleaseID, and for a prerelease the next prerelease and the release.
+func NextVersions(version, prereleaseID string) ([]Successor, error) {
+	current, err := ParseSemVer(version)
+	if err != nil {
+		return nil, err
+	}
+
+	if !IsPrerelease(prereleaseID) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPrerelease, prereleaseID)
+	}
+
+	var successors []Successor
+
+	for _, kind := range []string{Patch, Minor, Major} {
+		ver, err := BumpSemVer(kind, version, "", "")
+		if err != nil {
+			return nil, err
+		}
+
+		successors = append(successors, Successor{Kind: kind, Version: ver})
+	}
+
+	for _, pre := range []struct{ kind, semverID string }{
+		{Prepatch, Patch},
+		{Preminor, Minor},
+		{Premajor, Major},
+	} {
+		next, err := BumpSemVer(pre.semverID, version, "", "")
+		if err != nil {
+			return nil, err
+		}
+
+		ver, err := BumpSemVer(Prerelease, next.String(), prereleaseID, "")
+		if err != nil {
+			return nil, err
+		}
+
+		successors = append(successors, Successor{Kind: pre.kind, Version: ver})
+	}
+
+	if current.Prerelease != "" {
+		for _, kind := range []string{Prerelease, Release} {
+			ver, err := BumpSemVer(kind, version, "", "")
+			if err != nil {
+				return nil, err
+			}
+
+			successors = append(successors, Successor{Kind: kind, Version: ver})
+		}
+	}
+
+	return successors, nil
+}
@@ -0,0 +1,63 @@
+package gosemver_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+func TestNextVersions(t *testing.T) {
+	tests := []struct {
+		name         string
+		version      string
+		prereleaseID string
+		want         map[string]string
+		wantErr      error
+	}{
+		{
+			"release", "1.2.3", "rc.1",
+			map[string]string{
+				"patch": "1.2.4", "minor": "1.3.0", "major": "2.0.0",
+				"prepatch": "1.2.4-rc.1", "preminor": "1.3.0-rc.1", "premajor": "2.0.0-rc.1",
+			},
+			nil,
+		},
+		{
+			"prerelease", "v2.0.0-beta.2+build.5", "",
+			map[string]string{
+				"patch": "2.0.1", "minor": "2.1.0", "major": "3.0.0",
+				"prepatch": "2.0.1-1", "preminor": "2.1.0-1", "premajor": "3.0.0-1",
+				"prerelease": "2.0.0-beta.3", "release": "2.0.0",
+			},
+			nil,
+		},
+		{"invalid version", "1.2", "", nil, gosemver.ErrInvalidVersion},
+		{"invalid prerelease", "1.2.3", "rc..1", nil, gosemver.ErrInvalidPrerelease},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gosemver.NextVersions(tt.version, tt.prereleaseID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NextVersions() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("NextVersions() returned %d successors, want %d", len(got), len(tt.want))
+			}
+
+			for _, successor := range got {
+				if successor.Version.String() != tt.want[successor.Kind] {
+					t.Errorf("NextVersions() %s = %s, want %s", successor.Kind, successor.Version, tt.want[successor.Kind])
+				}
+
+				want, _, _ := strings.Cut(tt.want[successor.Kind], "-")
+				if successor.Version.Release != want {
+					t.Errorf("NextVersions() %s release = %s, want %s", successor.Kind, successor.Version.Release, want)
+				}
+			}
+		})
+	}
+}
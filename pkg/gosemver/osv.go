@@ -0,0 +1,259 @@
+package gosemver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+)
+
+// OSVRangeSemver is the type of OSV ranges whose events are semantic versions.
+const OSVRangeSemver = "SEMVER"
+
+// osvIntroducedAll is the introduced event of a range affecting all versions before its fixed event.
+const osvIntroducedAll = "0"
+
+var ErrInvalidOSV = errors.New("invalid OSV advisory")
+
+// OSVAdvisory holds the fields of an OSV advisory, see https://ossf.github.io/osv-schema/, needed to
+// tell whether it affects a version.
+type OSVAdvisory struct {
+	ID       string        `json:"id"                yaml:"id"`
+	Summary  string        `json:"summary,omitempty" yaml:"summary,omitempty"`
+	Aliases  []string      `json:"aliases,omitempty" yaml:"aliases,omitempty"`
+	Affected []OSVAffected `json:"affected"          yaml:"affected"`
+}
+
+// OSVAffected lists the affected versions of a package.
+type OSVAffected struct {
+	Package  OSVPackage `json:"package"            yaml:"package"`
+	Ranges   []OSVRange `json:"ranges,omitempty"   yaml:"ranges,omitempty"`
+	Versions []string   `json:"versions,omitempty" yaml:"versions,omitempty"`
+}
+
+// OSVPackage identifies a package in an ecosystem.
+type OSVPackage struct {
+	Ecosystem string `json:"ecosystem"      yaml:"ecosystem"`
+	Name      string `json:"name"           yaml:"name"`
+	Purl      string `json:"purl,omitempty" yaml:"purl,omitempty"`
+}
+
+// OSVRange describes affected versions by a list of events.
+type OSVRange struct {
+	Type   string     `json:"type"   yaml:"type"`
+	Events []OSVEvent `json:"events" yaml:"events"`
+}
+
+// OSVEvent starts or ends a span of affected versions, exactly one field is set.
+type OSVEvent struct {
+	Introduced   string `json:"introduced,omitempty"    yaml:"introduced,omitempty"`
+	Fixed        string `json:"fixed,omitempty"         yaml:"fixed,omitempty"`
+	LastAffected string `json:"last_affected,omitempty" yaml:"last_affected,omitempty"`
+	Limit        string `json:"limit,omitempty"         yaml:"limit,omitempty"`
+}
+
+// OSVMatch is a package of an advisory affected in a version.
+type OSVMatch struct {
+	ID      string     `json:"id"                yaml:"id"`
+	Summary string     `json:"summary,omitempty" yaml:"summary,omitempty"`
+	Aliases []string   `json:"aliases,omitempty" yaml:"aliases,omitempty"`
+	Package OSVPackage `json:"package"           yaml:"package"`
+	// Fixed is the first version fixing the affected span, empty if no fix is known.
+	Fixed string `json:"fixed,omitempty" yaml:"fixed,omitempty"`
+}
+
+// ParseOSVAdvisory parses an OSV advisory in JSON.
+func ParseOSVAdvisory(data []byte) (*OSVAdvisory, error) {
+	var advisory OSVAdvisory
+	if err := json.Unmarshal(data, &advisory); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidOSV, err)
+	}
+
+	if advisory.ID == "" {
+		return nil, fmt.Errorf("%w: missing id", ErrInvalidOSV)
+	}
+
+	return &advisory, nil
+}
+
+// ReadOSVAdvisory reads an OSV advisory from a JSON file.
+func ReadOSVAdvisory(path string) (*OSVAdvisory, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	advisory, err := ParseOSVAdvisory(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return advisory, nil
+}
+
+// Check returns the packages of the advisory affected in version, only those named pkg unless pkg
+// is empty. A package is affected if the version is listed in its versions or lies in one of its
+// SEMVER ranges, other range types are ignored.
+func (a *OSVAdvisory) Check(version, pkg string) ([]OSVMatch, error) {
+	if _, err := ParseSemVer(version); err != nil {
+		return nil, err
+	}
+
+	var matches []OSVMatch
+
+	for _, affected := range a.Affected {
+		if pkg != "" && affected.Package.Name != pkg {
+			continue
+		}
+
+		match := OSVMatch{ID: a.ID, Summary: a.Summary, Aliases: a.Aliases, Package: affected.Package}
+
+		hit := listsVersion(affected.Versions, version)
+
+		for _, r := range affected.Ranges {
+			if r.Type != OSVRangeSemver {
+				continue
+			}
+
+			inRange, fixed, err := r.Affects(version)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", a.ID, err)
+			}
+
+			if inRange {
+				hit = true
+				match.Fixed = fixed
+
+				break
+			}
+		}
+
+		if hit {
+			matches = append(matches, match)
+		}
+	}
+
+	return matches, nil
+}
+
+// Affects applies the OSV evaluation algorithm to a SEMVER range: walking the events sorted by
+// version, an introduced event at or below the version marks it affected, and a fixed event at or
+// below it or a last_affected event below it marks it unaffected. Limit events cap the range. It also
+// returns the fixed event ending the affected span, if any.
+func (r OSVRange) Affects(version string) (bool, string, error) {
+	events, err := r.sortedEvents()
+	if err != nil {
+		return false, "", err
+	}
+
+	var (
+		affected bool
+		limited  bool
+		limits   int
+	)
+
+	for _, event := range events {
+		v, _ := event.version()
+
+		cmp, err := CompareSemVer(version, v)
+		if err != nil {
+			return false, "", err
+		}
+
+		switch {
+		case event.Introduced != "" && cmp >= 0:
+			affected = true
+		case event.Fixed != "" && cmp >= 0:
+			affected = false
+		case event.LastAffected != "" && cmp > 0:
+			affected = false
+		case event.Limit != "":
+			limits++
+			if cmp < 0 {
+				limited = true
+			}
+		}
+	}
+
+	if !affected || (limits > 0 && !limited) {
+		return false, "", nil
+	}
+
+	// The affected span ends at the next event above the version, a fix if it is a fixed event.
+	for _, event := range events {
+		if v, _ := event.version(); event.Limit == "" && compareLater(version, v) {
+			return true, event.Fixed, nil
+		}
+	}
+
+	return true, "", nil
+}
+
+// sortedEvents validates the events and sorts them by version.
+func (r OSVRange) sortedEvents() ([]OSVEvent, error) {
+	events := slices.Clone(r.Events)
+	versions := make(map[string]*SemVer, len(events))
+
+	for _, event := range events {
+		v, ok := event.version()
+		if !ok {
+			return nil, fmt.Errorf("%w: event must set exactly one of introduced, fixed, last_affected or limit",
+				ErrInvalidOSV)
+		}
+
+		ver, err := ParseSemVer(v)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidOSV, err)
+		}
+		versions[v] = ver
+	}
+
+	slices.SortStableFunc(events, func(a, b OSVEvent) int {
+		va, _ := a.version()
+		vb, _ := b.version()
+
+		return compareVersions(versions[va], versions[vb])
+	})
+
+	return events, nil
+}
+
+// version returns the version of the event, with the introduced event "0" as the lowest version.
+func (e OSVEvent) version() (string, bool) {
+	var set []string
+
+	for _, v := range []string{e.Introduced, e.Fixed, e.LastAffected, e.Limit} {
+		if v != "" {
+			set = append(set, v)
+		}
+	}
+
+	if len(set) != 1 {
+		return "", false
+	}
+
+	if e.Introduced == osvIntroducedAll {
+		return lowestVersion.String(), true
+	}
+
+	return set[0], true
+}
+
+// compareLater reports whether other is a later version than version.
+func compareLater(version, other string) bool {
+	cmp, err := CompareSemVer(version, other)
+
+	return err == nil && cmp < 0
+}
+
+// listsVersion reports whether versions holds a version of the same precedence as version.
+func listsVersion(versions []string, version string) bool {
+	for _, v := range versions {
+		if cmp, err := CompareSemVer(version, v); err == nil && cmp == 0 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,180 @@
+package gosemver_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+const testAdvisory = `{
+  "id": "GHSA-aaaa-bbbb-cccc",
+  "summary": "Denial of service",
+  "aliases": ["CVE-2024-0001"],
+  "affected": [
+    {
+      "package": {"ecosystem": "Go", "name": "example.com/mod"},
+      "ranges": [
+        {
+          "type": "SEMVER",
+          "events": [{"introduced": "2.0.0"}, {"last_affected": "2.1.0"}, {"introduced": "0"}, {"fixed": "1.2.4"}]
+        }
+      ]
+    },
+    {
+      "package": {"ecosystem": "Go", "name": "example.com/other"},
+      "ranges": [{"type": "GIT", "events": [{"introduced": "0123abc"}]}],
+      "versions": ["1.0.0", "not a version"]
+    }
+  ]
+}`
+
+func TestOSVRangeAffects(t *testing.T) {
+	events := []gosemver.OSVEvent{
+		{Introduced: "1.0.0"},
+		{Fixed: "1.2.4"},
+		{Introduced: "2.0.0-rc.1"},
+		{LastAffected: "2.1.0"},
+		{Introduced: "3.0.0"},
+	}
+
+	tests := []struct {
+		version   string
+		want      bool
+		wantFixed string
+	}{
+		{"0.9.0", false, ""},
+		{"1.0.0", true, "1.2.4"},
+		{"1.2.3", true, "1.2.4"},
+		{"1.2.4", false, ""},
+		{"1.9.0", false, ""},
+		{"2.0.0-rc.1", true, ""},
+		{"2.1.0", true, ""},
+		{"2.1.1", false, ""},
+		{"3.5.0", true, ""},
+	}
+
+	r := gosemver.OSVRange{Type: gosemver.OSVRangeSemver, Events: events}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			got, fixed, err := r.Affects(tt.version)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != tt.want || fixed != tt.wantFixed {
+				t.Errorf("Affects() = %v, %q, want %v, %q", got, fixed, tt.want, tt.wantFixed)
+			}
+		})
+	}
+}
+
+func TestOSVRangeAffectsLimit(t *testing.T) {
+	r := gosemver.OSVRange{
+		Type:   gosemver.OSVRangeSemver,
+		Events: []gosemver.OSVEvent{{Introduced: "0"}, {Limit: "1.5.0"}},
+	}
+
+	for version, want := range map[string]bool{"1.0.0": true, "1.5.0": false, "2.0.0": false} {
+		if got, _, err := r.Affects(version); err != nil || got != want {
+			t.Errorf("Affects(%s) = %v, %v, want %v", version, got, err, want)
+		}
+	}
+}
+
+func TestOSVRangeAffectsInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		events []gosemver.OSVEvent
+	}{
+		{"invalid version", []gosemver.OSVEvent{{Introduced: "1.0"}}},
+		{"two fields", []gosemver.OSVEvent{{Introduced: "1.0.0", Fixed: "1.2.0"}}},
+		{"no field", []gosemver.OSVEvent{{}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gosemver.OSVRange{Type: gosemver.OSVRangeSemver, Events: tt.events}
+			if _, _, err := r.Affects("1.0.0"); !errors.Is(err, gosemver.ErrInvalidOSV) {
+				t.Errorf("Affects() error = %v, want %v", err, gosemver.ErrInvalidOSV)
+			}
+		})
+	}
+}
+
+func TestOSVAdvisoryCheck(t *testing.T) {
+	advisory, err := gosemver.ParseOSVAdvisory([]byte(testAdvisory))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		version   string
+		pkg       string
+		wantNames []string
+		wantFixed []string
+	}{
+		{"before fix", "1.0.0", "", []string{"example.com/mod", "example.com/other"}, []string{"1.2.4", ""}},
+		{"package filter", "1.0.0", "example.com/mod", []string{"example.com/mod"}, []string{"1.2.4"}},
+		{"fixed", "1.2.4", "", nil, nil},
+		{"last affected", "2.1.0", "", []string{"example.com/mod"}, []string{""}},
+		{"after last affected", "2.1.1", "", nil, nil},
+		{"build metadata", "1.0.0+build.5", "", []string{"example.com/mod", "example.com/other"}, []string{"1.2.4", ""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, err := advisory.Check(tt.version, tt.pkg)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(matches) != len(tt.wantNames) {
+				t.Fatalf("Check() = %+v, want packages %v", matches, tt.wantNames)
+			}
+
+			for i, match := range matches {
+				if match.ID != advisory.ID || match.Package.Name != tt.wantNames[i] || match.Fixed != tt.wantFixed[i] {
+					t.Errorf("Check()[%d] = %+v, want %s fixed in %q", i, match, tt.wantNames[i], tt.wantFixed[i])
+				}
+			}
+		})
+	}
+
+	if _, err := advisory.Check("1.0", ""); !errors.Is(err, gosemver.ErrInvalidVersion) {
+		t.Errorf("Check() error = %v, want %v", err, gosemver.ErrInvalidVersion)
+	}
+}
+
+func TestReadOSVAdvisory(t *testing.T) {
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, "valid.json")
+	if err := os.WriteFile(valid, []byte(testAdvisory), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	advisory, err := gosemver.ReadOSVAdvisory(valid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if advisory.ID != "GHSA-aaaa-bbbb-cccc" || len(advisory.Affected) != 2 {
+		t.Errorf("ReadOSVAdvisory() = %+v", advisory)
+	}
+
+	for name, content := range map[string]string{"invalid.json": `{"id": `, "noid.json": `{"affected": []}`} {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := gosemver.ReadOSVAdvisory(path); !errors.Is(err, gosemver.ErrInvalidOSV) {
+			t.Errorf("ReadOSVAdvisory(%s) error = %v, want %v", name, err, gosemver.ErrInvalidOSV)
+		}
+	}
+}
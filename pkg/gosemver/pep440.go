@@ -0,0 +1,367 @@
+package gosemver
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidPEP440  = errors.New("version does not comply with PEP 440")
+	ErrNotConvertible = errors.New("version cannot be converted without changing its meaning")
+)
+
+// PEP440Regexp matches a PEP 440 version in any of its permitted spellings, see
+// https://peps.python.org/pep-0440/#appendix-b-parsing-version-strings-with-regular-expressions.
+var PEP440Regexp = regexp.MustCompile(`(?i)^\s*v?` +
+	`(?:(?P<epoch>[0-9]+)!)?` +
+	`(?P<release>[0-9]+(?:\.[0-9]+)*)` +
+	`(?P<pre>[-_.]?(?P<pre_l>alpha|a|beta|b|preview|pre|rc|c)[-_.]?(?P<pre_n>[0-9]+)?)?` +
+	`(?P<post>-(?P<post_n1>[0-9]+)|[-_.]?(?P<post_l>post|rev|r)[-_.]?(?P<post_n2>[0-9]+)?)?` +
+	`(?P<dev>[-_.]?(?P<dev_l>dev)[-_.]?(?P<dev_n>[0-9]+)?)?` +
+	`(?:\+(?P<local>[a-z0-9]+(?:[-_.][a-z0-9]+)*))?\s*$`)
+
+// Prerelease labels of PEP 440 versions in their normalized spelling, by precedence.
+const (
+	PEP440Alpha            = "a"
+	PEP440Beta             = "b"
+	PEP440ReleaseCandidate = "rc"
+)
+
+var pep440PreLabels = map[string]string{
+	"a": PEP440Alpha, "alpha": PEP440Alpha,
+	"b": PEP440Beta, "beta": PEP440Beta,
+	"rc": PEP440ReleaseCandidate, "c": PEP440ReleaseCandidate, "pre": PEP440ReleaseCandidate,
+	"preview": PEP440ReleaseCandidate,
+}
+
+// semverPreLabels are the SemVer prerelease labels of the PEP 440 prerelease labels.
+var semverPreLabels = map[string]string{
+	PEP440Alpha:            "alpha",
+	PEP440Beta:             "beta",
+	PEP440ReleaseCandidate: "rc",
+}
+
+// PEP440Version holds the segments of a Python package version as defined by PEP 440, in the form
+// '[N!]N(.N)*[{a|b|rc}N][.postN][.devN][+local]'.
+type PEP440Version struct {
+	Epoch   int   `json:"epoch"   yaml:"epoch"`
+	Release []int `json:"release" yaml:"release"`
+	// PreLabel is 'a', 'b' or 'rc' for prereleases.
+	PreLabel  string `json:"pre_label,omitempty"  yaml:"pre_label,omitempty"`
+	PreNumber int    `json:"pre_number,omitempty" yaml:"pre_number,omitempty"`
+	Post      *int   `json:"post,omitempty"       yaml:"post,omitempty"`
+	Dev       *int   `json:"dev,omitempty"        yaml:"dev,omitempty"`
+	Local     string `json:"local,omitempty"      yaml:"local,omitempty"`
+}
+
+// ParsePEP440 parses a PEP 440 version, accepting the permitted alternative spellings like
+// '1.0-beta.2' or '1.0-1' for '1.0b2' and '1.0.post1'.
+func ParsePEP440(version string) (*PEP440Version, error) {
+	matches := PEP440Regexp.FindStringSubmatch(version)
+	if matches == nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidPEP440, version)
+	}
+
+	group := func(name string) string { return matches[PEP440Regexp.SubexpIndex(name)] }
+
+	var (
+		ver PEP440Version
+		err error
+	)
+
+	number := func(s string) int {
+		if s == "" || err != nil {
+			return 0
+		}
+
+		var n int
+		if n, err = strconv.Atoi(s); err != nil {
+			err = fmt.Errorf("%w: %s", ErrInvalidPEP440, version)
+		}
+
+		return n
+	}
+
+	ver.Epoch = number(group("epoch"))
+	for _, part := range strings.Split(group("release"), ".") {
+		ver.Release = append(ver.Release, number(part))
+	}
+
+	if label := group("pre_l"); label != "" {
+		ver.PreLabel = pep440PreLabels[strings.ToLower(label)]
+		ver.PreNumber = number(group("pre_n"))
+	}
+
+	if group("post") != "" {
+		post := number(group("post_n1") + group("post_n2"))
+		ver.Post = &post
+	}
+
+	if group("dev") != "" {
+		dev := number(group("dev_n"))
+		ver.Dev = &dev
+	}
+
+	if local := group("local"); local != "" {
+		ver.Local = strings.ToLower(strings.NewReplacer("-", ".", "_", ".").Replace(local))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ver, nil
+}
+
+// String returns the version in its normalized form.
+func (v PEP440Version) String() string {
+	var b strings.Builder
+
+	if v.Epoch != 0 {
+		fmt.Fprintf(&b, "%d!", v.Epoch)
+	}
+
+	for i, part := range v.Release {
+		if i > 0 {
+			b.WriteString(".")
+		}
+		b.WriteString(strconv.Itoa(part))
+	}
+
+	if v.PreLabel != "" {
+		fmt.Fprintf(&b, "%s%d", v.PreLabel, v.PreNumber)
+	}
+
+	if v.Post != nil {
+		fmt.Fprintf(&b, ".post%d", *v.Post)
+	}
+
+	if v.Dev != nil {
+		fmt.Fprintf(&b, ".dev%d", *v.Dev)
+	}
+
+	if v.Local != "" {
+		b.WriteString("+" + v.Local)
+	}
+
+	return b.String()
+}
+
+// Compare compares two versions by PEP 440 ordering, returning -1, 0 or 1. Trailing zeros of the
+// release do not matter, developmental releases precede prereleases, which precede the release,
+// which precedes post-releases, and a local version follows the same version without one.
+func (v PEP440Version) Compare(other PEP440Version) int {
+	if cmp := compareInts(v.Epoch, other.Epoch); cmp != 0 {
+		return cmp
+	}
+
+	if cmp := slices.Compare(trimZeros(v.Release), trimZeros(other.Release)); cmp != 0 {
+		return cmp
+	}
+
+	if cmp := compareInts(v.preRank(), other.preRank()); cmp != 0 {
+		return cmp
+	}
+
+	if v.PreLabel != "" {
+		if cmp := compareInts(v.PreNumber, other.PreNumber); cmp != 0 {
+			return cmp
+		}
+	}
+
+	// A missing post-release precedes any post-release, a missing dev release follows any.
+	if cmp := compareOptional(v.Post, other.Post, -1); cmp != 0 {
+		return cmp
+	}
+
+	if cmp := compareOptional(v.Dev, other.Dev, 1); cmp != 0 {
+		return cmp
+	}
+
+	return compareLocal(v.Local, other.Local)
+}
+
+// ComparePEP440 compares two PEP 440 versions, returning -1, 0 or 1.
+func ComparePEP440(version, otherVersion string) (int, error) {
+	left, err := ParsePEP440(version)
+	if err != nil {
+		return 0, err
+	}
+
+	right, err := ParsePEP440(otherVersion)
+	if err != nil {
+		return 0, err
+	}
+
+	return left.Compare(*right), nil
+}
+
+// SemVer converts the version to a semantic version: '1.2.3rc1' becomes '1.2.3-rc.1', 'a' and 'b'
+// become 'alpha' and 'beta'. Releases with more than three parts other than trailing zeros, epochs,
+// post-releases, developmental releases and local versions fail with ErrNotConvertible, as SemVer
+// cannot order them the same way.
+func (v PEP440Version) SemVer() (*SemVer, error) {
+	release := trimZeros(v.Release)
+
+	switch {
+	case v.Epoch != 0:
+		return nil, fmt.Errorf("%w: %s: SemVer has no epochs", ErrNotConvertible, v)
+	case len(release) > 3: //nolint:mnd
+		return nil, fmt.Errorf("%w: %s: SemVer has only three release numbers", ErrNotConvertible, v)
+	case v.Post != nil:
+		return nil, fmt.Errorf("%w: %s: SemVer has no post-releases", ErrNotConvertible, v)
+	case v.Dev != nil:
+		return nil, fmt.Errorf("%w: %s: SemVer has no label ordering developmental releases before alpha",
+			ErrNotConvertible, v)
+	case v.Local != "":
+		return nil, fmt.Errorf("%w: %s: SemVer ignores build metadata when ordering versions", ErrNotConvertible, v)
+	}
+
+	var parts [3]int
+	copy(parts[:], release)
+
+	prerelease := ""
+	if v.PreLabel != "" {
+		prerelease = fmt.Sprintf("%s.%d", semverPreLabels[v.PreLabel], v.PreNumber)
+	}
+
+	return newSemVer(parts[0], parts[1], parts[2], prerelease), nil
+}
+
+// PEP440FromSemVer converts a semantic version to a PEP 440 version: '1.2.3-rc.1' becomes
+// '1.2.3rc1'. The prerelease must be 'alpha', 'beta' or 'rc' and a number, like 'rc.1', as SemVer
+// orders other spellings differently: 'rc10' before 'rc9' or 'pre.1' before 'rc.1'. Other prereleases
+// and build metadata, which PEP 440 would order as a local version, fail with ErrNotConvertible.
+func PEP440FromSemVer(ver *SemVer) (*PEP440Version, error) {
+	v := &PEP440Version{Release: []int{ver.Major, ver.Minor, ver.Patch}}
+
+	if ver.Prerelease != "" {
+		label, number, err := splitPrereleaseLabel(ver.Prerelease)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrNotConvertible, ver, err)
+		}
+
+		v.PreLabel, v.PreNumber = label, number
+	}
+
+	if ver.Build != "" {
+		return nil, fmt.Errorf("%w: %s: PEP 440 orders local versions after the version, SemVer ignores build metadata",
+			ErrNotConvertible, ver)
+	}
+
+	return v, nil
+}
+
+// splitPrereleaseLabel splits a SemVer prerelease like 'rc.1' into a PEP 440 label and number.
+func splitPrereleaseLabel(prerelease string) (string, int, error) {
+	semverLabel, digits, _ := strings.Cut(prerelease, ".")
+
+	label := ""
+	for pep440Label, semver := range semverPreLabels {
+		if semver == semverLabel {
+			label = pep440Label
+		}
+	}
+
+	number, err := strconv.Atoi(digits)
+	if label == "" || err != nil || strconv.Itoa(number) != digits {
+		return "", 0, fmt.Errorf("prerelease %q is not one of alpha, beta or rc and a number", prerelease)
+	}
+
+	return label, number, nil
+}
+
+// preRank orders developmental releases without a prerelease, prereleases by label, releases and
+// post-releases.
+func (v PEP440Version) preRank() int {
+	switch {
+	case v.PreLabel == "" && v.Post == nil && v.Dev != nil:
+		return 0
+	case v.PreLabel == PEP440Alpha:
+		return 1
+	case v.PreLabel == PEP440Beta:
+		return 2 //nolint:mnd
+	case v.PreLabel == PEP440ReleaseCandidate:
+		return 3 //nolint:mnd
+	default:
+		return 4 //nolint:mnd
+	}
+}
+
+// compareOptional compares optional numbers, a missing number compares as missing against any number.
+func compareOptional(a, b *int, missing int) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return missing
+	case b == nil:
+		return -missing
+	default:
+		return compareInts(*a, *b)
+	}
+}
+
+// compareLocal compares local versions segment by segment, numeric segments following alphanumeric
+// ones, and a missing local version preceding any.
+func compareLocal(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return -1
+	case b == "":
+		return 1
+	}
+
+	left, right := strings.Split(a, "."), strings.Split(b, ".")
+
+	for i := 0; i < len(left) && i < len(right); i++ {
+		ln, lErr := strconv.Atoi(left[i])
+		rn, rErr := strconv.Atoi(right[i])
+
+		var cmp int
+
+		switch {
+		case lErr == nil && rErr == nil:
+			cmp = compareInts(ln, rn)
+		case lErr == nil:
+			cmp = 1
+		case rErr == nil:
+			cmp = -1
+		default:
+			cmp = strings.Compare(left[i], right[i])
+		}
+
+		if cmp != 0 {
+			return cmp
+		}
+	}
+
+	return compareInts(len(left), len(right))
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// trimZeros drops the trailing zeros of a release, as '1.0' equals '1.0.0'.
+func trimZeros(release []int) []int {
+	end := len(release)
+	for end > 0 && release[end-1] == 0 {
+		end--
+	}
+
+	return release[:end]
+}
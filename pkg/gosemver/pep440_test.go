@@ -0,0 +1,266 @@
+package gosemver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+func TestParsePEP440(t *testing.T) {
+	tests := []struct {
+		version string
+		want    string
+		wantErr error
+	}{
+		{"1.2.3", "1.2.3", nil},
+		{"1.2.3rc1", "1.2.3rc1", nil},
+		{"1!2.0", "1!2.0", nil},
+		{"1.2.3.post2", "1.2.3.post2", nil},
+		{"1.0.dev0", "1.0.dev0", nil},
+		{"1.0a1.post2.dev3+ubuntu.1", "1.0a1.post2.dev3+ubuntu.1", nil},
+		{"v1.0", "1.0", nil},
+		{" 1.0\n", "1.0", nil},
+		{"1.0-ALPHA.2", "1.0a2", nil},
+		{"1.0_beta_2", "1.0b2", nil},
+		{"1.0c1", "1.0rc1", nil},
+		{"1.0preview1", "1.0rc1", nil},
+		{"1.0rc", "1.0rc0", nil},
+		{"1.0-1", "1.0.post1", nil},
+		{"1.0.rev2", "1.0.post2", nil},
+		{"1.0-r", "1.0.post0", nil},
+		{"1.0-dev", "1.0.dev0", nil},
+		{"01.002", "1.2", nil},
+		{"1.0+Ubuntu-1_2", "1.0+ubuntu.1.2", nil},
+		{"1.0+", "", gosemver.ErrInvalidPEP440},
+		{"1.0rc1rc2", "", gosemver.ErrInvalidPEP440},
+		{"1.0-beta.2-1", "1.0b2.post1", nil},
+		{"1.2.3-rc.1.2", "", gosemver.ErrInvalidPEP440},
+		{"latest", "", gosemver.ErrInvalidPEP440},
+		{"", "", gosemver.ErrInvalidPEP440},
+		{"99999999999999999999.0", "", gosemver.ErrInvalidPEP440},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			got, err := gosemver.ParsePEP440(tt.version)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParsePEP440() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err == nil && got.String() != tt.want {
+				t.Errorf("ParsePEP440() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComparePEP440(t *testing.T) {
+	// Versions in ascending order, from the examples of PEP 440.
+	ordered := []string{
+		"1.0.dev456",
+		"1.0a1",
+		"1.0a2.dev456",
+		"1.0a12.dev456",
+		"1.0a12",
+		"1.0b1.dev456",
+		"1.0b2",
+		"1.0b2.post345.dev456",
+		"1.0b2.post345",
+		"1.0rc1.dev456",
+		"1.0rc1",
+		"1.0",
+		"1.0+abc.5",
+		"1.0+abc.7",
+		"1.0+5",
+		"1.0.post456.dev34",
+		"1.0.post456",
+		"1.0.15",
+		"1.1.dev1",
+		"2!0.1",
+	}
+
+	for i := range ordered {
+		for j := range ordered {
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+
+			got, err := gosemver.ComparePEP440(ordered[i], ordered[j])
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != want {
+				t.Errorf("ComparePEP440(%s, %s) = %d, want %d", ordered[i], ordered[j], got, want)
+			}
+		}
+	}
+
+	for _, pair := range [][2]string{{"1.0", "1.0.0"}, {"1.0rc1", "1.0c1"}, {"1.0.post0", "1.0-0"}} {
+		if got, err := gosemver.ComparePEP440(pair[0], pair[1]); err != nil || got != 0 {
+			t.Errorf("ComparePEP440(%s, %s) = %d, %v, want 0", pair[0], pair[1], got, err)
+		}
+	}
+
+	if _, err := gosemver.ComparePEP440("1.0", "bogus"); !errors.Is(err, gosemver.ErrInvalidPEP440) {
+		t.Errorf("ComparePEP440() error = %v, want %v", err, gosemver.ErrInvalidPEP440)
+	}
+}
+
+func TestPEP440SemVer(t *testing.T) {
+	tests := []struct {
+		version string
+		want    string
+		wantErr error
+	}{
+		{"1.2.3", "1.2.3", nil},
+		{"1.2.3rc1", "1.2.3-rc.1", nil},
+		{"1.2.3a1", "1.2.3-alpha.1", nil},
+		{"1.2b2", "1.2.0-beta.2", nil},
+		{"1.2.3.dev4", "", gosemver.ErrNotConvertible},
+		{"1.2.3+ubuntu.1", "", gosemver.ErrNotConvertible},
+		{"1.2.3.0.0", "1.2.3", nil},
+		{"0", "0.0.0", nil},
+		{"1!2.0", "", gosemver.ErrNotConvertible},
+		{"1.2.3.post2", "", gosemver.ErrNotConvertible},
+		{"1.2.3rc1.dev2", "", gosemver.ErrNotConvertible},
+		{"1.2.3.4", "", gosemver.ErrNotConvertible},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			v, err := gosemver.ParsePEP440(tt.version)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := v.SemVer()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SemVer() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err == nil && got.String() != tt.want {
+				t.Errorf("SemVer() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPEP440FromSemVer(t *testing.T) {
+	tests := []struct {
+		version string
+		want    string
+		wantErr error
+	}{
+		{"1.2.3", "1.2.3", nil},
+		{"1.2.3-rc.1", "1.2.3rc1", nil},
+		{"1.2.3-alpha.1", "1.2.3a1", nil},
+		{"1.2.3-beta.0", "1.2.3b0", nil},
+		{"1.2.3-rc1", "", gosemver.ErrNotConvertible},
+		{"1.2.3-RC.1", "", gosemver.ErrNotConvertible},
+		{"1.2.3-beta", "", gosemver.ErrNotConvertible},
+		{"1.2.3-a.1", "", gosemver.ErrNotConvertible},
+		{"1.2.3-pre.1", "", gosemver.ErrNotConvertible},
+		{"1.2.3-preview.2", "", gosemver.ErrNotConvertible},
+		{"1.2.3-dev.4", "", gosemver.ErrNotConvertible},
+		{"1.2.3+build.5", "", gosemver.ErrNotConvertible},
+		{"1.2.3-foo", "", gosemver.ErrNotConvertible},
+		{"1.2.3-rc.1.2", "", gosemver.ErrNotConvertible},
+		{"1.2.3-1", "", gosemver.ErrNotConvertible},
+		{"1.2.3+a--b", "", gosemver.ErrNotConvertible},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			ver, err := gosemver.ParseSemVer(tt.version)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := gosemver.PEP440FromSemVer(ver)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PEP440FromSemVer() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err == nil && got.String() != tt.want {
+				t.Errorf("PEP440FromSemVer() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPEP440RoundTrip(t *testing.T) {
+	for _, version := range []string{"1.2.3", "1.2.3-alpha.1", "1.2.3-beta.2", "1.2.3-rc.1"} {
+		t.Run(version, func(t *testing.T) {
+			ver, err := gosemver.ParseSemVer(version)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			converted, err := gosemver.PEP440FromSemVer(ver)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			back, err := converted.SemVer()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if back.String() != version {
+				t.Errorf("round trip of %s through %s = %s", version, converted, back)
+			}
+		})
+	}
+}
+
+func TestPEP440ConversionOrder(t *testing.T) {
+	versions := []string{
+		"1.2.3-alpha.1", "1.2.3-alpha.2", "1.2.3-alpha.10", "1.2.3-beta.0", "1.2.3-beta.9", "1.2.3-rc.1",
+		"1.2.3-rc.9", "1.2.3-rc.10", "1.2.3", "1.2.4-alpha.0", "1.10.0", "2.0.0-rc.2",
+	}
+
+	for _, left := range versions {
+		for _, right := range versions {
+			l, r := mustPEP440FromSemVer(t, left), mustPEP440FromSemVer(t, right)
+
+			want, err := gosemver.CompareSemVer(left, right)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := l.Compare(*r); got != want {
+				t.Errorf("PEP 440 %s compared to %s = %d, want %d as for %s and %s", l, r, got, want, left, right)
+			}
+
+			back, err := l.SemVer()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if back.String() != left {
+				t.Errorf("round trip of %s through %s = %s", left, l, back)
+			}
+		}
+	}
+}
+
+func mustPEP440FromSemVer(t *testing.T, version string) *gosemver.PEP440Version {
+	t.Helper()
+
+	ver, err := gosemver.ParseSemVer(version)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := gosemver.PEP440FromSemVer(ver)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return v
+}
@@ -0,0 +1,128 @@
+package gosemver
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	ErrNotPseudoVersion = errors.New("not a pseudo-version")
+	ErrInvalidRevision  = errors.New("revision must be a hexadecimal commit hash")
+
+	// PseudoVersionRegexp matches the prerelease and build of the three Go pseudo-version forms:
+	// vX.0.0-yyyymmddhhmmss-abcdefabcdef, vX.Y.Z-pre.0.yyyymmddhhmmss-abcdefabcdef and
+	// vX.Y.Z-0.yyyymmddhhmmss-abcdefabcdef.
+	PseudoVersionRegexp = regexp.MustCompile(
+		`^(?:(.+)\.0\.|0\.)?([0-9]{14})-([0-9A-Za-z]+)(?:\+.+)?$`,
+	)
+	revisionRegexp = regexp.MustCompile(`^[0-9a-fA-F]{12,}$`)
+)
+
+const (
+	pseudoTimeFormat     = "20060102150405"
+	pseudoRevisionLength = 12
+)
+
+// PseudoVersion holds the parts of a Go pseudo-version.
+type PseudoVersion struct {
+	Version *SemVer `json:"version"`
+	// Base is the release or prerelease the pseudo-version is derived from, empty for the vX.0.0 form.
+	Base     string    `json:"base"`
+	Time     time.Time `json:"time"`
+	Revision string    `json:"revision"`
+}
+
+// IsPseudoVersion checks if a string is a Go pseudo-version.
+func IsPseudoVersion(version string) bool {
+	_, err := ParsePseudoVersion(version)
+
+	return err == nil
+}
+
+// ParsePseudoVersion parses a Go pseudo-version into its base version, commit time and revision.
+func ParsePseudoVersion(version string) (*PseudoVersion, error) {
+	ver, err := ParseSemVer(version)
+	if err != nil {
+		return nil, err
+	}
+
+	suffix := ver.Prerelease
+	if ver.Build != "" {
+		suffix += "+" + ver.Build
+	}
+
+	matches := PseudoVersionRegexp.FindStringSubmatch(suffix)
+	if matches == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNotPseudoVersion, version)
+	}
+
+	commitTime, err := time.Parse(pseudoTimeFormat, matches[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %w", ErrNotPseudoVersion, version, err)
+	}
+
+	pseudo := &PseudoVersion{Version: ver, Time: commitTime, Revision: matches[3]}
+
+	switch {
+	case matches[1] != "": // vX.Y.Z-pre.0.yyyymmddhhmmss-abcdefabcdef
+		pseudo.Base = fmt.Sprintf("v%s-%s", ver.Release, matches[1])
+	case strings.HasPrefix(suffix, "0."): // vX.Y.Z-0.yyyymmddhhmmss-abcdefabcdef
+		if ver.Patch == 0 {
+			return nil, fmt.Errorf("%w: %s: patch must be greater than zero", ErrNotPseudoVersion, version)
+		}
+
+		pseudo.Base = fmt.Sprintf("v%d.%d.%d", ver.Major, ver.Minor, ver.Patch-1)
+	default: // vX.0.0-yyyymmddhhmmss-abcdefabcdef
+		if ver.Minor != 0 || ver.Patch != 0 {
+			return nil, fmt.Errorf("%w: %s: base is missing", ErrNotPseudoVersion, version)
+		}
+	}
+
+	return pseudo, nil
+}
+
+// NewPseudoVersion generates a Go pseudo-version for a commit on top of the base version. An empty
+// base produces the vX.0.0 form for the given major. The revision is shortened to 12 characters.
+func NewPseudoVersion(major int, base string, commitTime time.Time, revision string) (string, error) {
+	if !revisionRegexp.MatchString(revision) {
+		return "", fmt.Errorf("%w: %s", ErrInvalidRevision, revision)
+	}
+
+	suffix := commitTime.UTC().Format(pseudoTimeFormat) + "-" + strings.ToLower(revision[:pseudoRevisionLength])
+
+	if base == "" {
+		return fmt.Sprintf("v%d.0.0-%s", major, suffix), nil
+	}
+
+	ver, err := ParseSemVer(base)
+	if err != nil {
+		return "", err
+	}
+
+	build := ""
+	if ver.Build != "" {
+		build = "+" + ver.Build
+	}
+
+	if ver.Prerelease != "" {
+		return fmt.Sprintf("v%s-%s.0.%s%s", ver.Release, ver.Prerelease, suffix, build), nil
+	}
+
+	return fmt.Sprintf("v%d.%d.%d-0.%s%s", ver.Major, ver.Minor, ver.Patch+1, suffix, build), nil
+}
+
+// CompareGoVersions compares versions the way the Go toolchain sorts module versions. Pseudo-versions
+// need no special handling as their precedence places them after their base, before any later
+// release or prerelease, and orders them by commit time. Unlike CompareSemVer, versions of equal
+// precedence are ordered by their string, e.g. by build metadata, which makes the order total.
+func CompareGoVersions(version, otherVersion string) (int, error) {
+	cmp, err := CompareSemVer(version, otherVersion)
+	if err != nil || cmp != 0 {
+		return cmp, err
+	}
+
+	return strings.Compare(version, otherVersion), nil
+}
@@ -0,0 +1,125 @@
+package gosemver_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+func TestParsePseudoVersion(t *testing.T) {
+	commitTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		version  string
+		wantBase string
+		wantErr  error
+	}{
+		{"no base", "v0.0.0-20240101120000-abcdef123456", "", nil},
+		{"no base v2", "v2.0.0-20240101120000-abcdef123456", "", nil},
+		{"release base", "v1.2.4-0.20240101120000-abcdef123456", "v1.2.3", nil},
+		{"prerelease base", "v1.2.3-beta.2.0.20240101120000-abcdef123456", "v1.2.3-beta.2", nil},
+		{"incompatible", "v2.0.1-0.20240101120000-abcdef123456+incompatible", "v2.0.0", nil},
+		{"release", "v1.2.3", "", gosemver.ErrNotPseudoVersion},
+		{"prerelease", "v1.2.3-rc.1", "", gosemver.ErrNotPseudoVersion},
+		{"no base with minor", "v1.2.0-20240101120000-abcdef123456", "", gosemver.ErrNotPseudoVersion},
+		{"release base with zero patch", "v1.2.0-0.20240101120000-abcdef123456", "", gosemver.ErrNotPseudoVersion},
+		{"invalid time", "v0.0.0-20241301120000-abcdef123456", "", gosemver.ErrNotPseudoVersion},
+		{"invalid version", "v1.2-0.20240101120000-abcdef123456", "", gosemver.ErrInvalidVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gosemver.ParsePseudoVersion(tt.version)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParsePseudoVersion() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if gosemver.IsPseudoVersion(tt.version) != (tt.wantErr == nil) {
+				t.Errorf("IsPseudoVersion() = %v, want %v", tt.wantErr != nil, tt.wantErr == nil)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.Base != tt.wantBase || !got.Time.Equal(commitTime) || got.Revision != "abcdef123456" {
+				t.Errorf("ParsePseudoVersion() = %s, %s, %s, want %s, %s, abcdef123456",
+					got.Base, got.Time, got.Revision, tt.wantBase, commitTime)
+			}
+		})
+	}
+}
+
+func TestNewPseudoVersion(t *testing.T) {
+	commitTime := time.Date(2024, 1, 1, 13, 0, 0, 0, time.FixedZone("CET", 3600))
+
+	tests := []struct {
+		name     string
+		major    int
+		base     string
+		revision string
+		want     string
+		wantErr  error
+	}{
+		{"no base", 0, "", "abcdef1234567890", "v0.0.0-20240101120000-abcdef123456", nil},
+		{"no base v2", 2, "", "abcdef1234567890", "v2.0.0-20240101120000-abcdef123456", nil},
+		{"release base", 0, "v1.2.3", "abcdef1234567890", "v1.2.4-0.20240101120000-abcdef123456", nil},
+		{"prerelease base", 0, "1.2.3-beta.2", "abcdef123456", "v1.2.3-beta.2.0.20240101120000-abcdef123456", nil},
+		{"incompatible base", 0, "v2.0.0+incompatible", "abcdef123456", "v2.0.1-0.20240101120000-abcdef123456+incompatible", nil},
+		{"short revision", 0, "v1.2.3", "abcdef", "", gosemver.ErrInvalidRevision},
+		{"invalid revision", 0, "v1.2.3", "not-a-commit-hash", "", gosemver.ErrInvalidRevision},
+		{"invalid base", 0, "1.2", "abcdef123456", "", gosemver.ErrInvalidVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gosemver.NewPseudoVersion(tt.major, tt.base, commitTime, tt.revision)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewPseudoVersion() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("NewPseudoVersion() = %s, want %s", got, tt.want)
+			}
+
+			if err == nil && !gosemver.IsPseudoVersion(got) {
+				t.Errorf("NewPseudoVersion() = %s is not a pseudo-version", got)
+			}
+		})
+	}
+}
+
+func TestCompareGoVersions(t *testing.T) {
+	want := []string{
+		"v0.0.0-20230101120000-abcdef123456",
+		"v0.0.0-20240101120000-abcdef123456",
+		"v1.2.3-beta.2",
+		"v1.2.3-beta.2.0.20240101120000-abcdef123456",
+		"v1.2.3-beta.3",
+		"v1.2.3",
+		"v1.2.4-0.20230101120000-abcdef123456",
+		"v1.2.4-0.20240101120000-abcdef123456",
+		"v1.2.4-alpha",
+		"v1.2.4",
+		"v1.2.4+meta",
+	}
+
+	got := slices.Clone(want)
+	slices.Reverse(got)
+	slices.SortFunc(got, func(a, b string) int {
+		cmp, err := gosemver.CompareGoVersions(a, b)
+		if err != nil {
+			t.Fatalf("CompareGoVersions() error = %v", err)
+		}
+
+		return cmp
+	})
+
+	if !slices.Equal(got, want) {
+		t.Errorf("CompareGoVersions() order = %v, want %v", got, want)
+	}
+}
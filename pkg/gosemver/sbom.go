@@ -0,0 +1,202 @@
+package gosemver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	ErrUnknownSBOM   = errors.New("unknown SBOM format, expected CycloneDX or SPDX JSON")
+	ErrInvalidPolicy = errors.New("invalid version policy")
+)
+
+// Statuses of a component checked against a VersionPolicy.
+const (
+	ComponentOK          = "ok"
+	ComponentViolation   = "violation"
+	ComponentUnparseable = "unparseable"
+	ComponentMissing     = "missing"
+)
+
+// SBOMComponent is a component listed in a software bill of materials.
+type SBOMComponent struct {
+	// Name is the component name, prefixed with its group and a '/' in CycloneDX.
+	Name    string `json:"name"    yaml:"name"`
+	Version string `json:"version" yaml:"version"`
+}
+
+// VersionPolicy maps component names to the constraints their versions must satisfy.
+type VersionPolicy struct {
+	// Syntax of the constraints, the native syntax by default.
+	Syntax     string            `json:"syntax"     yaml:"syntax"`
+	Components map[string]string `json:"components" yaml:"components"`
+}
+
+// ComponentCheck is the outcome of checking a component against a VersionPolicy.
+type ComponentCheck struct {
+	Name       string `json:"name"       yaml:"name"`
+	Version    string `json:"version"    yaml:"version"`
+	Constraint string `json:"constraint" yaml:"constraint"`
+	// Coerced is the version as a semantic version, empty if it cannot be coerced.
+	Coerced string `json:"coerced,omitempty" yaml:"coerced,omitempty"`
+	Status  string `json:"status"            yaml:"status"`
+}
+
+type cycloneDXComponent struct {
+	Group      string               `json:"group"`
+	Name       string               `json:"name"`
+	Version    string               `json:"version"`
+	Components []cycloneDXComponent `json:"components"`
+}
+
+type sbomDocument struct {
+	BOMFormat   string               `json:"bomFormat"`
+	SPDXVersion string               `json:"spdxVersion"`
+	Components  []cycloneDXComponent `json:"components"`
+	Packages    []struct {
+		Name        string `json:"name"`
+		VersionInfo string `json:"versionInfo"`
+	} `json:"packages"`
+}
+
+// ParseSBOM returns the components of a CycloneDX or SPDX SBOM in JSON, including the nested
+// components of CycloneDX.
+func ParseSBOM(data []byte) ([]SBOMComponent, error) {
+	var doc sbomDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnknownSBOM, err)
+	}
+
+	var components []SBOMComponent
+
+	switch {
+	case doc.BOMFormat == "CycloneDX":
+		components = appendCycloneDX(components, doc.Components)
+	case doc.SPDXVersion != "":
+		for _, pkg := range doc.Packages {
+			components = append(components, SBOMComponent{Name: pkg.Name, Version: pkg.VersionInfo})
+		}
+	default:
+		return nil, ErrUnknownSBOM
+	}
+
+	return components, nil
+}
+
+// ReadSBOM reads the components of a CycloneDX or SPDX SBOM from a JSON file.
+func ReadSBOM(path string) ([]SBOMComponent, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	components, err := ParseSBOM(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return components, nil
+}
+
+func appendCycloneDX(components []SBOMComponent, cdx []cycloneDXComponent) []SBOMComponent {
+	for _, component := range cdx {
+		name := component.Name
+		if component.Group != "" {
+			name = component.Group + "/" + name
+		}
+
+		components = append(components, SBOMComponent{Name: name, Version: component.Version})
+		components = appendCycloneDX(components, component.Components)
+	}
+
+	return components
+}
+
+// ParseVersionPolicy parses a version policy in YAML or JSON, like:
+//
+//	syntax: npm
+//	components:
+//	  openssl: ">=3.0.7"
+//	  org.apache.logging.log4j/log4j-core: ">=2.17.1"
+func ParseVersionPolicy(data []byte) (*VersionPolicy, error) {
+	var policy VersionPolicy
+	if err := yaml.Unmarshal(data, &policy); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPolicy, err)
+	}
+
+	for name, constraint := range policy.Components {
+		if _, err := ParseConstraintSyntax(constraint, policy.Syntax); err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrInvalidPolicy, name, err)
+		}
+	}
+
+	return &policy, nil
+}
+
+// ReadVersionPolicy reads a version policy from a YAML or JSON file.
+func ReadVersionPolicy(path string) (*VersionPolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := ParseVersionPolicy(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return policy, nil
+}
+
+// Check checks the components named in the policy, in their order, coercing their versions to
+// semantic versions. A component is unparseable if its version cannot be coerced. Components of the
+// policy absent from the SBOM follow as missing, sorted by name.
+func (p *VersionPolicy) Check(components []SBOMComponent) ([]ComponentCheck, error) {
+	checks := []ComponentCheck{}
+	found := make(map[string]bool, len(p.Components))
+
+	for _, component := range components {
+		expr, ok := p.Components[component.Name]
+		if !ok {
+			continue
+		}
+		found[component.Name] = true
+
+		constraint, err := ParseConstraintSyntax(expr, p.Syntax)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrInvalidPolicy, component.Name, err)
+		}
+
+		check := ComponentCheck{
+			Name:       component.Name,
+			Version:    component.Version,
+			Constraint: expr,
+			Status:     ComponentUnparseable,
+		}
+
+		if ver, err := CoerceSemVer(component.Version); err == nil {
+			check.Coerced = ver.String()
+			check.Status = ComponentViolation
+
+			if constraint.Check(ver) {
+				check.Status = ComponentOK
+			}
+		}
+
+		checks = append(checks, check)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(p.Components)) {
+		if !found[name] {
+			checks = append(checks, ComponentCheck{Name: name, Constraint: p.Components[name], Status: ComponentMissing})
+		}
+	}
+
+	return checks, nil
+}
@@ -0,0 +1,114 @@
+package gosemver_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+func TestParseSBOM(t *testing.T) {
+	tests := []struct {
+		name    string
+		sbom    string
+		want    []gosemver.SBOMComponent
+		wantErr error
+	}{
+		{
+			name: "CycloneDX",
+			sbom: `{"bomFormat": "CycloneDX", "components": [
+				{"group": "org.example", "name": "lib", "version": "1.0",
+				 "components": [{"name": "nested", "version": "2.0.0"}]},
+				{"name": "tool", "version": "v3"}]}`,
+			want: []gosemver.SBOMComponent{
+				{Name: "org.example/lib", Version: "1.0"},
+				{Name: "nested", Version: "2.0.0"},
+				{Name: "tool", Version: "v3"},
+			},
+		},
+		{
+			name: "SPDX",
+			sbom: `{"spdxVersion": "SPDX-2.3", "packages": [{"name": "openssl", "versionInfo": "3.0.13"}]}`,
+			want: []gosemver.SBOMComponent{{Name: "openssl", Version: "3.0.13"}},
+		},
+		{name: "unknown format", sbom: `{"components": []}`, wantErr: gosemver.ErrUnknownSBOM},
+		{name: "invalid JSON", sbom: `{"bomFormat": `, wantErr: gosemver.ErrUnknownSBOM},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gosemver.ParseSBOM([]byte(tt.sbom))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseSBOM() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ParseSBOM() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersionPolicyCheck(t *testing.T) {
+	policy, err := gosemver.ParseVersionPolicy([]byte(`
+components:
+  openssl: ">=3.0.7"
+  org.example/lib: "^1.2.0"
+  busybox: ">=1.36.0"
+  libpng: ">=1.6.0"
+  curl: ">=8.0.0"
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	components := []gosemver.SBOMComponent{
+		{Name: "openssl", Version: "3.1"},
+		{Name: "org.example/lib", Version: "1.1.9"},
+		{Name: "busybox", Version: "1:1.36.1-r2"},
+		{Name: "zlib", Version: "1.2.13"},
+	}
+
+	got, err := policy.Check(components)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []gosemver.ComponentCheck{
+		{Name: "openssl", Version: "3.1", Constraint: ">=3.0.7", Coerced: "3.1.0", Status: gosemver.ComponentOK},
+		{
+			Name: "org.example/lib", Version: "1.1.9", Constraint: "^1.2.0", Coerced: "1.1.9",
+			Status: gosemver.ComponentViolation,
+		},
+		{Name: "busybox", Version: "1:1.36.1-r2", Constraint: ">=1.36.0", Status: gosemver.ComponentUnparseable},
+		{Name: "curl", Constraint: ">=8.0.0", Status: gosemver.ComponentMissing},
+		{Name: "libpng", Constraint: ">=1.6.0", Status: gosemver.ComponentMissing},
+	}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("Check() = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseVersionPolicy(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  string
+		wantErr error
+	}{
+		{"maven syntax", "syntax: maven\ncomponents:\n  lib: '[1.2,2.0)'", nil},
+		{"JSON", `{"components": {"lib": "^1.2.0"}}`, nil},
+		{"invalid constraint", "components:\n  lib: '>=1.x.2'", gosemver.ErrInvalidPolicy},
+		{"unknown syntax", "syntax: gradle\ncomponents:\n  lib: '1.2'", gosemver.ErrInvalidPolicy},
+		{"invalid YAML", "components: [", gosemver.ErrInvalidPolicy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := gosemver.ParseVersionPolicy([]byte(tt.policy)); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseVersionPolicy() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package gosemver
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrNoVersions = errors.New("no versions to select from")
+
+// MaxVersion returns the version with the highest precedence. Of versions with equal precedence the
+// first one is returned.
+func MaxVersion(versions []string) (string, error) {
+	return selectVersion(versions, func(cmp int) bool { return cmp > 0 })
+}
+
+// MinVersion returns the version with the lowest precedence. Of versions with equal precedence the
+// first one is returned.
+func MinVersion(versions []string) (string, error) {
+	return selectVersion(versions, func(cmp int) bool { return cmp < 0 })
+}
+
+// LatestVersion returns the highest release, or the highest prerelease if there are no releases.
+func LatestVersion(versions []string) (string, error) {
+	var releases []string
+
+	for _, version := range versions {
+		ver, err := ParseSemVer(version)
+		if err != nil {
+			return "", err
+		}
+
+		if ver.Prerelease == "" {
+			releases = append(releases, version)
+		}
+	}
+
+	if len(releases) > 0 {
+		return MaxVersion(releases)
+	}
+
+	return MaxVersion(versions)
+}
+
+// selectVersion returns the first version for which better holds against every preceding choice.
+func selectVersion(versions []string, better func(cmp int) bool) (string, error) {
+	if len(versions) == 0 {
+		return "", ErrNoVersions
+	}
+
+	selected := versions[0]
+	if !IsSemVer(selected) {
+		return "", fmt.Errorf("%w: %s", ErrInvalidVersion, selected)
+	}
+
+	for _, version := range versions[1:] {
+		cmp, err := CompareSemVer(version, selected)
+		if err != nil {
+			return "", err
+		}
+
+		if better(cmp) {
+			selected = version
+		}
+	}
+
+	return selected, nil
+}
@@ -0,0 +1,42 @@
+package gosemver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+func TestSelectVersion(t *testing.T) {
+	versions := []string{"1.2.0", "v2.0.0-rc.1", "1.10.0", "0.9.0", "1.10.0+build.1"}
+
+	tests := []struct {
+		name     string
+		selector func([]string) (string, error)
+		versions []string
+		want     string
+		wantErr  error
+	}{
+		{"max", gosemver.MaxVersion, versions, "v2.0.0-rc.1", nil},
+		{"min", gosemver.MinVersion, versions, "0.9.0", nil},
+		{"latest", gosemver.LatestVersion, versions, "1.10.0", nil},
+		{"latest prereleases only", gosemver.LatestVersion, []string{"1.0.0-alpha", "1.0.0-beta"}, "1.0.0-beta", nil},
+		{"max single", gosemver.MaxVersion, []string{"1.0.0"}, "1.0.0", nil},
+		{"max empty", gosemver.MaxVersion, nil, "", gosemver.ErrNoVersions},
+		{"min invalid", gosemver.MinVersion, []string{"1.0.0", "1.0"}, "", gosemver.ErrInvalidVersion},
+		{"latest invalid", gosemver.LatestVersion, []string{"latest"}, "", gosemver.ErrInvalidVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.selector(tt.versions)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("selector error = %v, want %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("selector = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
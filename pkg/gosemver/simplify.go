@@ -0,0 +1,295 @@
+package gosemver
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Simplify returns the shortest native constraint matching the versions of the set. Every range is
+// written as the shortest of an exact version, an x-range, a caret or tilde range, a hyphen range or
+// comparators, with partial versions for upper bounds like '<2', matching the same prereleases. It
+// fails with ErrInexpressible if no native constraint matches the same prereleases.
+func (s VersionSet) Simplify() (string, error) {
+	ranges, err := npmRanges(s)
+	if err != nil {
+		return "", err
+	}
+
+	if len(ranges) == 0 {
+		return OpLess + lowestVersion.String(), nil
+	}
+
+	simplified := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		simplified = append(simplified, simplifyRange(r))
+	}
+
+	return strings.Join(simplified, " || "), nil
+}
+
+// Equal reports whether both sets hold the same versions.
+func (s IntervalSet) Equal(other IntervalSet) bool {
+	return slices.EqualFunc(s, other, func(a, b Interval) bool {
+		return equalBounds(unboundedLowest(a.Lower), unboundedLowest(b.Lower)) && equalBounds(a.Upper, b.Upper)
+	})
+}
+
+// SimplifyConstraint returns the shortest constraint in the given syntax matching the same versions
+// as c. Only the native syntax has shorthand forms to choose from, other syntaxes get the set as
+// formatted by FormatConstraint. With versions, only the matching of those versions is kept, as by
+// npm's simplifyRange: every run of consecutive matching versions becomes an exact version, a hyphen
+// range, or an open range at the ends of the list. The shorter of both forms is returned.
+func SimplifyConstraint(c *Constraint, versions []*SemVer, syntax string) (string, error) {
+	native := syntax == SyntaxNative || syntax == SyntaxNpm || syntax == ""
+
+	var (
+		simplified string
+		err        error
+	)
+
+	if native {
+		simplified, err = c.VersionSet().Simplify()
+	} else {
+		simplified, err = FormatConstraint(c.VersionSet(), syntax)
+	}
+
+	if len(versions) == 0 {
+		return simplified, err
+	}
+
+	relative := simplifyRelative(c, versions)
+	if relative != "" && !native {
+		// The runs are written natively, a syntax unable to express them leaves the full form.
+		r, parseErr := ParseConstraint(relative)
+		if relative = ""; parseErr == nil {
+			relative, _ = FormatConstraint(r.VersionSet(), syntax)
+		}
+	}
+
+	if relative != "" && (err != nil || len(relative) < len(simplified)) {
+		return relative, nil
+	}
+
+	return simplified, err
+}
+
+// SimplifyRange returns the shortest native constraint matching the same versions as the constraint
+// expression among versions. Strings which are not semantic versions are skipped.
+func SimplifyRange(versions []string, constraint string) (string, error) {
+	c, err := ParseConstraint(constraint)
+	if err != nil {
+		return "", err
+	}
+
+	var parsed []*SemVer
+
+	for _, version := range versions {
+		if ver, err := ParseSemVer(version); err == nil {
+			parsed = append(parsed, ver)
+		}
+	}
+
+	return SimplifyConstraint(c, parsed, SyntaxNative)
+}
+
+// simplifyRelative describes the runs of consecutive versions satisfying the constraint, or returns
+// an empty string if none does.
+func simplifyRelative(c *Constraint, versions []*SemVer) string {
+	sorted := slices.Clone(versions)
+	slices.SortStableFunc(sorted, compareVersions)
+
+	var (
+		ranges      []string
+		first, last *SemVer
+	)
+
+	for _, ver := range sorted {
+		if c.Check(ver) {
+			if first == nil {
+				first = ver
+			}
+			last = ver
+
+			continue
+		}
+
+		if first != nil {
+			ranges = append(ranges, describeRun(first, last, sorted[0]))
+			first = nil
+		}
+	}
+
+	if first != nil {
+		ranges = append(ranges, describeRun(first, nil, sorted[0]))
+	}
+
+	return strings.Join(ranges, " || ")
+}
+
+// describeRun describes the versions from first to last, a nil last meaning the end of the list.
+func describeRun(first, last, lowest *SemVer) string {
+	switch {
+	case first == last:
+		return OpEqual + first.String()
+	case last == nil && first == lowest:
+		return "*"
+	case last == nil:
+		return OpGreaterOrEqual + first.String()
+	case first == lowest:
+		return OpLessOrEqual + last.String()
+	default:
+		return fmt.Sprintf("%s - %s", first, last)
+	}
+}
+
+// simplifyRange returns the shortest candidate expression matching the same versions as the range.
+func simplifyRange(r Range) string {
+	want := (&Constraint{Ranges: []Range{r}}).VersionSet()
+	best := r.String()
+
+	for _, interval := range boundVariants(r.Interval()) {
+		for _, candidate := range interval.candidates() {
+			if len(candidate) >= len(best) {
+				continue
+			}
+
+			if c, err := ParseConstraint(candidate); err == nil && c.VersionSet().Equal(want) {
+				best = candidate
+			}
+		}
+	}
+
+	return best
+}
+
+// boundVariants returns the interval along with the same interval bounded by neighbouring versions:
+// '>=1.0.1 <1.5.1' also as '>1.0.0 <=1.5.0', which holds the same releases.
+func boundVariants(i Interval) []Interval {
+	i.Lower = unboundedLowest(i.Lower)
+
+	lowers, uppers := []Bound{i.Lower}, []Bound{i.Upper}
+	if ver := i.Lower.Version; ver != nil && i.Lower.Inclusive {
+		if previous := predecessor(ver); previous != nil {
+			lowers = append(lowers, Bound{Version: previous})
+		}
+	}
+
+	if ver := i.Upper.Version; ver != nil && !i.Upper.Inclusive {
+		if previous := predecessor(ver); previous != nil {
+			uppers = append(uppers, Bound{Version: previous, Inclusive: true})
+		}
+	}
+
+	variants := make([]Interval, 0, len(lowers)*len(uppers))
+	for _, lower := range lowers {
+		for _, upper := range uppers {
+			variants = append(variants, Interval{Lower: lower, Upper: upper})
+		}
+	}
+
+	return variants
+}
+
+// candidates returns expressions which may describe the interval, in order of preference.
+func (i Interval) candidates() []string {
+	lower, upper := i.Lower.Version, i.Upper.Version
+
+	if lower == nil && upper == nil {
+		return []string{"*"}
+	}
+
+	var candidates []string
+
+	if lower != nil && upper != nil && i.Lower.Inclusive && i.Upper.Inclusive {
+		if compareVersions(lower, upper) == 0 {
+			return []string{OpEqual + lower.String()}
+		}
+
+		candidates = append(candidates, fmt.Sprintf("%s - %s", lower, upper))
+	}
+
+	if lower != nil && i.Lower.Inclusive && upper != nil && !i.Upper.Inclusive {
+		if lower.Prerelease == "" && lower.Patch == 0 {
+			if lower.Minor == 0 {
+				candidates = append(candidates, fmt.Sprintf("%d.x", lower.Major))
+			}
+			candidates = append(candidates, fmt.Sprintf("%d.%d.x", lower.Major, lower.Minor))
+		}
+
+		candidates = append(candidates, OpCaret+lower.String(), OpTilde+lower.String())
+	}
+
+	for _, l := range lowerCandidates(i.Lower) {
+		for _, u := range upperCandidates(i.Upper) {
+			candidates = append(candidates, strings.TrimSpace(l+" "+u))
+		}
+	}
+
+	return candidates
+}
+
+func lowerCandidates(bound Bound) []string {
+	switch {
+	case bound.Version == nil:
+		return []string{""}
+	case bound.Inclusive:
+		return []string{OpGreaterOrEqual + bound.Version.String()}
+	default:
+		return []string{OpGreater + bound.Version.String()}
+	}
+}
+
+// upperCandidates returns the comparators for an upper bound, with partial versions like '<1.2'
+// meaning '<1.2.0-0', which excludes the same releases as '<1.2.0'.
+func upperCandidates(bound Bound) []string {
+	ver := bound.Version
+
+	switch {
+	case ver == nil:
+		return []string{""}
+	case bound.Inclusive:
+		return []string{OpLessOrEqual + ver.String()}
+	}
+
+	candidates := []string{OpLess + ver.String()}
+	if (ver.Prerelease == "0" || ver.Prerelease == "") && ver.Patch == 0 {
+		candidates = append(candidates, fmt.Sprintf("%s%d.%d", OpLess, ver.Major, ver.Minor))
+		if ver.Minor == 0 {
+			candidates = append(candidates, fmt.Sprintf("%s%d", OpLess, ver.Major))
+		}
+	}
+
+	return candidates
+}
+
+// predecessor returns the version right before ver among the releases, or among the prereleases for
+// a successor like '1.2.3-rc.1.0', or nil if there is none.
+func predecessor(ver *SemVer) *SemVer {
+	if previous, ok := strings.CutSuffix(ver.Prerelease, ".0"); ok {
+		return newSemVer(ver.Major, ver.Minor, ver.Patch, previous)
+	}
+
+	if ver.Prerelease == "" && ver.Patch > 0 {
+		return newSemVer(ver.Major, ver.Minor, ver.Patch-1, "")
+	}
+
+	return nil
+}
+
+// unboundedLowest returns an unbounded lower bound for '>=0.0.0-0', which admits every version.
+func unboundedLowest(bound Bound) Bound {
+	if bound.Version != nil && bound.Inclusive && compareVersions(bound.Version, lowestVersion) == 0 {
+		return Bound{}
+	}
+
+	return bound
+}
+
+func equalBounds(a, b Bound) bool {
+	if a.Version == nil || b.Version == nil {
+		return a.Version == nil && b.Version == nil
+	}
+
+	return a.Inclusive == b.Inclusive && compareVersions(a.Version, b.Version) == 0
+}
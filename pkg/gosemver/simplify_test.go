@@ -0,0 +1,137 @@
+package gosemver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+func TestVersionSetSimplify(t *testing.T) {
+	tests := []struct {
+		name       string
+		constraint string
+		want       string
+	}{
+		{"merged", ">=1.0.0 <3.0.0 || >=1.5.0 <2.0.0 || 2.1.x", ">=1.0.0 <3"},
+		{"caret", ">=1.2.0 <2.0.0-0 || 1.5.x", "^1.2.0"},
+		{"caret zero major", ">=0.2.3 <0.3.0-0", "^0.2.3"},
+		{"tilde", ">=1.2.3 <1.3.0-0", "~1.2.3"},
+		{"major x-range", ">=1.0.0 <2.0.0-0", "1.x"},
+		{"release upper bound", ">=1.0.0 <2.0.0", "1.x"},
+		{"minor x-range", "~1.2.0", "1.2.x"},
+		{"exact", ">=1.0.0 <=1.0.0", "=1.0.0"},
+		{"hyphen", ">=1.0.0 <=2.0.0", "1.0.0 - 2.0.0"},
+		{"partial upper bound", "<2.0.0-0", "<2"},
+		{"partial minor upper bound", ">1.0.0 <1.4.0-0", ">1.0.0 <1.4"},
+		{"neighbouring releases", ">1.0.0 <=1.5.0", "1.0.1 - 1.5.0"},
+		{"alternatives", "^1.2.3 || 3.x || >=5.0.0", "^1.2.3 || 3.x || >=5.0.0"},
+		{"prerelease caret", "^1.2.3-rc.2", "^1.2.3-rc.2"},
+		{"prerelease kept", "1.2.3-rc.1 || >=1.0.0 <2.0.0", "1.x || =1.2.3-rc.1"},
+		{"prereleases of a bound", ">=1.0.0 <=2.0.0-rc.1", "1.0.0 - 2.0.0-rc.1"},
+		{"any", "*", "*"},
+		{"lowest prerelease", ">=0.0.0-0", ">=0.0.0-0"},
+		{"empty", ">2.0.0 <1.0.0", "<0.0.0-0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set := mustVersionSet(t, tt.constraint)
+
+			got, err := set.Simplify()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != tt.want {
+				t.Errorf("Simplify() = %q, want %q", got, tt.want)
+			}
+
+			if !mustVersionSet(t, got).Equal(set) {
+				t.Errorf("Simplify() = %q does not match the same versions as %q", got, tt.constraint)
+			}
+
+			checkProbes(t, set, mustConstraint(t, got, gosemver.SyntaxNpm).Check)
+		})
+	}
+
+	if _, err := mustConstraint(t, "[1.0,2.0)", gosemver.SyntaxMaven).VersionSet().Simplify(); !errors.Is(err, gosemver.ErrInexpressible) {
+		t.Errorf("Simplify() of a Maven range error = %v, want %v", err, gosemver.ErrInexpressible)
+	}
+}
+
+func TestSimplifyRange(t *testing.T) {
+	versions := []string{"1.0.1", "1.0.2", "1.0.3", "1.1.0", "1.2.0", "2.0.0", "not a version"}
+
+	tests := []struct {
+		name       string
+		constraint string
+		want       string
+	}{
+		{"inner run", "1.0.2 || 1.0.3 || 1.1.0 || 1.2.0", "1.0.2 - 1.2.0"},
+		{"single version", ">1.0.3 <1.1.0 || 1.1.0", "=1.1.0"},
+		{"from the lowest version", ">=1.0.1 <1.0.4", "<=1.0.3"},
+		{"to the highest version", ">=1.1.0 || 1.0.0", ">=1.1.0"},
+		{"all versions", ">=1.0.0 <3.0.0 || 2.1.0", "*"},
+		{"several runs", "1.0.1 || 1.0.3 - 1.1.0 || 2.0.0", "=1.0.1 || 1.0.3 - 1.1.0 || =2.0.0"},
+		{"shorter without versions", "1.x", "1.x"},
+		{"no match", "3.x", "3.x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gosemver.SimplifyRange(versions, tt.constraint)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != tt.want {
+				t.Errorf("SimplifyRange() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := gosemver.SimplifyRange(versions, "1.2.3.4"); err == nil {
+		t.Error("SimplifyRange() of an invalid constraint succeeded")
+	}
+}
+
+func TestSimplifyConstraintSyntax(t *testing.T) {
+	versions := []*gosemver.SemVer{}
+	for _, version := range []string{"1.2.0", "1.3.0", "1.4.0", "2.0.0"} {
+		ver, err := gosemver.ParseSemVer(version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		versions = append(versions, ver)
+	}
+
+	tests := []struct {
+		name       string
+		constraint string
+		syntax     string
+		versions   []*gosemver.SemVer
+		want       string
+	}{
+		{"cargo", "1.2, <1.5", gosemver.SyntaxCargo, nil, ">=1.2.0, <1.5.0"},
+		{"maven", "[1.0,2.0),[1.5,3.0)", gosemver.SyntaxMaven, nil, "[1.0.0,3.0.0)"},
+		{"gem", "~> 1.2, >= 1.2.3", gosemver.SyntaxGem, nil, ">= 1.2.3, < 2.0.0"},
+		{"cargo among versions", ">=1.2.0, <1.5.0", gosemver.SyntaxCargo, versions, "<1.4.1"},
+		{"native", ">=1.2.0 <2.0.0", gosemver.SyntaxNative, nil, "^1.2.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := gosemver.SimplifyConstraint(mustConstraint(t, tt.constraint, tt.syntax), tt.versions, tt.syntax)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != tt.want {
+				t.Errorf("SimplifyConstraint() = %q, want %q", got, tt.want)
+			}
+
+			mustConstraint(t, got, tt.syntax)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package gosemver
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+var ErrOutOfSync = errors.New("versions are out of sync")
+
+// SyncStatus describes the version found in one file compared to the source of truth.
+type SyncStatus struct {
+	File    VersionFile
+	Version string
+	InSync  bool
+	Err     error
+}
+
+// CheckSync compares the versions stored in files with version. By default versions are in sync if
+// they have the same precedence, with exact they must also have the same prerelease and build
+// metadata. A 'v' prefix is never significant.
+func CheckSync(version string, files []VersionFile, exact bool) ([]SyncStatus, error) {
+	if _, err := ParseSemVer(version); err != nil {
+		return nil, err
+	}
+
+	statuses := make([]SyncStatus, 0, len(files))
+	outOfSync := false
+
+	for _, file := range files {
+		status := SyncStatus{File: file}
+
+		status.Version, status.Err = file.Read()
+		if status.Err == nil {
+			status.InSync, status.Err = versionsInSync(version, status.Version, exact)
+		}
+
+		outOfSync = outOfSync || !status.InSync
+		statuses = append(statuses, status)
+	}
+
+	if outOfSync {
+		return statuses, ErrOutOfSync
+	}
+
+	return statuses, nil
+}
+
+func versionsInSync(version, other string, exact bool) (bool, error) {
+	if exact {
+		left, err := ParseSemVer(version)
+		if err != nil {
+			return false, err
+		}
+
+		right, err := ParseSemVer(other)
+		if err != nil {
+			return false, err
+		}
+
+		return left.String() == right.String(), nil
+	}
+
+	cmp, err := CompareSemVer(version, other)
+
+	return cmp == 0, err
+}
+
+// ApplySync writes version to every file, keeping a 'v' prefix where a file already uses one.
+// All files are read and updated in memory first, so nothing is written if any of them cannot be
+// updated; then every file is replaced through a temporary file and a rename.
+func ApplySync(version string, files []VersionFile) error {
+	ver, err := ParseSemVer(version)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{}
+	contents := map[string][]byte{}
+
+	for _, file := range files {
+		content, ok := contents[file.Path]
+		if !ok {
+			if content, err = os.ReadFile(file.Path); err != nil {
+				return err
+			}
+
+			paths = append(paths, file.Path)
+		}
+
+		start, end, err := LocateVersion(file.Path, content, file.Selector)
+		if err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+
+		current := string(content[start:end])
+		newVersion := current[:len(current)-len(strings.TrimLeft(current, "vV"))] + ver.String()
+
+		if contents[file.Path], err = ReplaceVersion(file.Path, content, file.Selector, newVersion); err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+	}
+
+	tmps := make([]string, 0, len(paths))
+
+	defer func() {
+		for _, tmp := range tmps {
+			os.Remove(tmp) //nolint:errcheck,gosec
+		}
+	}()
+
+	for _, path := range paths {
+		tmp, err := writeTemp(path, contents[path])
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+
+		tmps = append(tmps, tmp)
+	}
+
+	for i, path := range paths {
+		if err := os.Rename(tmps[i], path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package gosemver_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func TestCheckSync(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"package.json": `{"version": "1.2.3"}`,
+		"Chart.yaml":   "version: v1.2.3+build.5\nappVersion: 1.2.2\n",
+		"VERSION":      "1.2.3-rc.1\n",
+	})
+
+	files := []gosemver.VersionFile{
+		{Path: filepath.Join(dir, "package.json")},
+		{Path: filepath.Join(dir, "Chart.yaml")},
+		{Path: filepath.Join(dir, "Chart.yaml"), Selector: "appVersion"},
+		{Path: filepath.Join(dir, "VERSION")},
+		{Path: filepath.Join(dir, "missing.json")},
+	}
+
+	tests := []struct {
+		name   string
+		exact  bool
+		inSync []bool
+	}{
+		{"precedence", false, []bool{true, true, false, false, false}},
+		{"exact", true, []bool{true, false, false, false, false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statuses, err := gosemver.CheckSync("1.2.3", files, tt.exact)
+			if !errors.Is(err, gosemver.ErrOutOfSync) {
+				t.Fatalf("CheckSync() error = %v, want %v", err, gosemver.ErrOutOfSync)
+			}
+
+			for i, status := range statuses {
+				if status.InSync != tt.inSync[i] {
+					t.Errorf("CheckSync() %s in sync = %v, want %v", status.File, status.InSync, tt.inSync[i])
+				}
+			}
+
+			if statuses[4].Err == nil {
+				t.Errorf("CheckSync() %s error = nil, want an error", statuses[4].File)
+			}
+		})
+	}
+
+	if _, err := gosemver.CheckSync("1.2.3", files[:2], false); err != nil {
+		t.Errorf("CheckSync() error = %v, want nil", err)
+	}
+
+	if _, err := gosemver.CheckSync("1.2", files, false); !errors.Is(err, gosemver.ErrInvalidVersion) {
+		t.Errorf("CheckSync() error = %v, want %v", err, gosemver.ErrInvalidVersion)
+	}
+}
+
+func TestApplySync(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"package.json": "{\n  \"version\": \"1.2.2\"\n}\n",
+		"Chart.yaml":   "version: v1.2.2 # chart\nappVersion: \"1.0.0\"\n",
+	})
+
+	files := []gosemver.VersionFile{
+		{Path: filepath.Join(dir, "package.json")},
+		{Path: filepath.Join(dir, "Chart.yaml")},
+		{Path: filepath.Join(dir, "Chart.yaml"), Selector: "appVersion"},
+	}
+
+	failing := append(files, gosemver.VersionFile{Path: filepath.Join(dir, "package.json"), Selector: "/missing"})
+	if err := gosemver.ApplySync("1.3.0", failing); !errors.Is(err, gosemver.ErrSelectorNotFound) {
+		t.Fatalf("ApplySync() error = %v, want %v", err, gosemver.ErrSelectorNotFound)
+	}
+
+	if content, _ := os.ReadFile(filepath.Join(dir, "package.json")); string(content) != "{\n  \"version\": \"1.2.2\"\n}\n" {
+		t.Errorf("ApplySync() modified files on failure: %q", content)
+	}
+
+	if err := gosemver.ApplySync("1.3.0", files); err != nil {
+		t.Fatalf("ApplySync() error = %v", err)
+	}
+
+	want := map[string]string{
+		"package.json": "{\n  \"version\": \"1.3.0\"\n}\n",
+		"Chart.yaml":   "version: v1.3.0 # chart\nappVersion: \"1.3.0\"\n",
+	}
+
+	for name, content := range want {
+		got, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil || string(got) != content {
+			t.Errorf("ApplySync() %s = %q, %v, want %q", name, got, err, content)
+		}
+	}
+
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != len(want) {
+		t.Errorf("ApplySync() left temporary files: %v", entries)
+	}
+}
@@ -0,0 +1,138 @@
+package gosemver
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrNoVersionTags   = errors.New("no semantic version tags found")
+	ErrVersionMismatch = errors.New("tag does not match the declared version")
+	ErrNoComponent     = errors.New("tag has no component path")
+)
+
+// Component is a versioned part of a monorepo tagged as "<path>/<prefix><version>".
+type Component struct {
+	Path    string
+	Tag     string
+	Version *SemVer
+}
+
+// ParseTag strips the prefix from a tag and parses the remainder as a semantic version.
+func ParseTag(tag, prefix string) (*SemVer, error) {
+	version, ok := strings.CutPrefix(tag, prefix)
+	if !ok {
+		return nil, fmt.Errorf("%w: tag %s has no prefix %s", ErrInvalidVersion, tag, prefix)
+	}
+
+	return ParseSemVer(version)
+}
+
+// LatestTag returns the tag with the highest version precedence among tags with the given
+// prefix. Tags which are not semantic versions are ignored.
+func LatestTag(tags []string, prefix string) (string, *SemVer, error) {
+	var (
+		latestTag string
+		latest    *SemVer
+	)
+
+	for _, tag := range tags {
+		ver, err := ParseTag(tag, prefix)
+		if err != nil {
+			continue
+		}
+
+		if latest != nil {
+			cmp, err := CompareSemVer(latest.String(), ver.String())
+			if err != nil || cmp >= 0 {
+				continue
+			}
+		}
+
+		latestTag, latest = tag, ver
+	}
+
+	if latest == nil {
+		return "", nil, fmt.Errorf("%w: prefix %q", ErrNoVersionTags, prefix)
+	}
+
+	return latestTag, latest, nil
+}
+
+// VerifyTag checks that a tag denotes exactly the declared version, including prerelease
+// and build metadata.
+func VerifyTag(tag, prefix, declared string) error {
+	tagVer, err := ParseTag(tag, prefix)
+	if err != nil {
+		return err
+	}
+
+	declaredVer, err := ParseSemVer(declared)
+	if err != nil {
+		return err
+	}
+
+	if tagVer.String() != declaredVer.String() {
+		return fmt.Errorf("%w: tag %s, declared %s", ErrVersionMismatch, tag, declared)
+	}
+
+	return nil
+}
+
+// ComponentTagPrefix returns the tag prefix of a component located at path,
+// e.g. "services/api/v" for "services/api" and "v".
+func ComponentTagPrefix(path, prefix string) string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return prefix
+	}
+
+	return path + "/" + prefix
+}
+
+// ParseComponentTag splits a tag like "services/api/v1.4.0" into the component path and its version.
+func ParseComponentTag(tag, prefix string) (string, *SemVer, error) {
+	for i := range len(tag) {
+		if tag[i] != '/' || i == 0 {
+			continue
+		}
+
+		if ver, err := ParseTag(tag[i+1:], prefix); err == nil {
+			return tag[:i], ver, nil
+		}
+	}
+
+	return "", nil, fmt.Errorf("%w: %s", ErrNoComponent, tag)
+}
+
+// LatestComponents returns the latest version of every component found in tags, sorted by path.
+func LatestComponents(tags []string, prefix string) []Component {
+	latest := map[string]Component{}
+
+	for _, tag := range tags {
+		path, ver, err := ParseComponentTag(tag, prefix)
+		if err != nil {
+			continue
+		}
+
+		if current, ok := latest[path]; ok {
+			cmp, err := CompareSemVer(current.Version.String(), ver.String())
+			if err != nil || cmp >= 0 {
+				continue
+			}
+		}
+
+		latest[path] = Component{Path: path, Tag: tag, Version: ver}
+	}
+
+	components := make([]Component, 0, len(latest))
+	for _, component := range latest {
+		components = append(components, component)
+	}
+
+	slices.SortFunc(components, func(a, b Component) int { return strings.Compare(a.Path, b.Path) })
+
+	return components
+}
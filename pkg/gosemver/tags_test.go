@@ -0,0 +1,129 @@
+package gosemver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+func TestLatestTag(t *testing.T) {
+	tests := []struct {
+		name    string
+		tags    []string
+		prefix  string
+		wantTag string
+		wantErr error
+	}{
+		{"highest wins", []string{"v1.0.0", "v1.10.0", "v1.2.0"}, "v", "v1.10.0", nil},
+		{"release over prerelease", []string{"v2.0.0-rc.1", "v2.0.0", "v1.9.9"}, "v", "v2.0.0", nil},
+		{"non-version tags ignored", []string{"latest", "v1.0.0", "nightly-2024"}, "v", "v1.0.0", nil},
+		{"other prefix ignored", []string{"v3.0.0", "release-1.0.0"}, "release-", "release-1.0.0", nil},
+		{"empty prefix", []string{"1.0.0", "v1.1.0"}, "", "v1.1.0", nil},
+		{"no tags", nil, "v", "", gosemver.ErrNoVersionTags},
+		{"no matching tags", []string{"foo", "bar"}, "v", "", gosemver.ErrNoVersionTags},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tag, _, err := gosemver.LatestTag(tt.tags, tt.prefix)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("LatestTag() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if tag != tt.wantTag {
+				t.Errorf("LatestTag() = %q, want %q", tag, tt.wantTag)
+			}
+		})
+	}
+}
+
+func TestVerifyTag(t *testing.T) {
+	tests := []struct {
+		name     string
+		tag      string
+		prefix   string
+		declared string
+		wantErr  error
+	}{
+		{"match", "v1.2.3", "v", "1.2.3", nil},
+		{"match with v in file", "v1.2.3", "v", "v1.2.3", nil},
+		{"match prerelease and build", "v1.2.3-rc.1+b5", "v", "1.2.3-rc.1+b5", nil},
+		{"mismatch", "v1.2.3", "v", "1.2.4", gosemver.ErrVersionMismatch},
+		{"mismatch build", "v1.2.3+b5", "v", "1.2.3+b6", gosemver.ErrVersionMismatch},
+		{"wrong prefix", "release-1.2.3", "v", "1.2.3", gosemver.ErrInvalidVersion},
+		{"invalid declared", "v1.2.3", "v", "1.2", gosemver.ErrInvalidVersion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := gosemver.VerifyTag(tt.tag, tt.prefix, tt.declared)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("VerifyTag() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseComponentTag(t *testing.T) {
+	tests := []struct {
+		name          string
+		tag           string
+		prefix        string
+		wantComponent string
+		wantVersion   string
+		wantErr       bool
+	}{
+		{"nested path", "services/api/v1.4.0", "v", "services/api", "1.4.0", false},
+		{"single segment", "web/v0.1.0-rc.1", "v", "web", "0.1.0-rc.1", false},
+		{"prefix with slash", "svc/release/1.0.0", "release/", "svc", "1.0.0", false},
+		{"no component", "v1.4.0", "v", "", "", true},
+		{"leading slash", "/v1.4.0", "v", "", "", true},
+		{"no version", "services/api/latest", "v", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			component, ver, err := gosemver.ParseComponentTag(tt.tag, tt.prefix)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseComponentTag() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if component != tt.wantComponent || ver.String() != tt.wantVersion {
+				t.Errorf("ParseComponentTag() = %q, %s, want %q, %s", component, ver, tt.wantComponent, tt.wantVersion)
+			}
+		})
+	}
+}
+
+func TestLatestComponents(t *testing.T) {
+	tags := []string{
+		"v9.9.9",
+		"services/api/v1.4.0",
+		"services/api/v1.10.0",
+		"services/api/v2.0.0-rc.1",
+		"libs/log/v0.3.0",
+		"libs/log/nightly",
+	}
+
+	got := gosemver.LatestComponents(tags, "v")
+	want := []string{"libs/log=0.3.0", "services/api=2.0.0-rc.1"}
+
+	if len(got) != len(want) {
+		t.Fatalf("LatestComponents() = %v, want %v", got, want)
+	}
+
+	for i, component := range got {
+		if s := component.Path + "=" + component.Version.String(); s != want[i] {
+			t.Errorf("LatestComponents()[%d] = %s, want %s", i, s, want[i])
+		}
+	}
+
+	if prefix := gosemver.ComponentTagPrefix("/services/api/", "v"); prefix != "services/api/v" {
+		t.Errorf("ComponentTagPrefix() = %s, want services/api/v", prefix)
+	}
+}
@@ -0,0 +1,190 @@
+package gosemver
+
+// VersionSet is the set of versions a constraint matches under its prerelease rule. Releases and
+// prereleases are kept apart, as the npm rule matches only the prereleases of versions a range
+// names: '>=1.2.3-rc.1 <2.0.0' matches the releases from 1.2.3 below 2.0.0 but the prereleases of
+// 1.2.3 alone.
+//
+// Both sets have inclusive lower bounds on versions they hold and exclusive upper bounds on
+// versions they do not, releases in Releases and prereleases in Prereleases, with the lowest
+// version left unbounded. Equal sets of versions thus have equal intervals.
+type VersionSet struct {
+	Releases    IntervalSet
+	Prereleases IntervalSet
+}
+
+// NewVersionSet returns the versions in the set by precedence, prereleases included.
+func NewVersionSet(set IntervalSet) VersionSet {
+	return VersionSet{Releases: releasesOf(set), Prereleases: prereleasesOf(set)}
+}
+
+// VersionSet returns the versions satisfying the constraint. Without IncludePrerelease, a range
+// holds only the prereleases of the versions its comparators name.
+func (c *Constraint) VersionSet() VersionSet {
+	if c.IncludePrerelease {
+		return NewVersionSet(c.IntervalSet())
+	}
+
+	var releases, prereleases []Interval
+
+	for _, r := range c.Ranges {
+		interval := r.Interval()
+		releases = append(releases, interval)
+
+		for _, comparator := range r {
+			if ver := comparator.Version; ver.Prerelease != "" {
+				tuple := Interval{
+					Lower: Bound{Version: exclusiveUpper(ver), Inclusive: true},
+					Upper: Bound{Version: newSemVer(ver.Major, ver.Minor, ver.Patch, "")},
+				}
+				prereleases = append(prereleases, interval.intersect(tuple))
+			}
+		}
+	}
+
+	return VersionSet{Releases: releasesOf(releases), Prereleases: prereleasesOf(prereleases)}
+}
+
+// Contains reports whether a version is in the set.
+func (s VersionSet) Contains(ver *SemVer) bool {
+	if ver.Prerelease != "" {
+		return s.Prereleases.Contains(ver)
+	}
+
+	return s.Releases.Contains(ver)
+}
+
+// IsEmpty reports whether the set has no versions.
+func (s VersionSet) IsEmpty() bool {
+	return s.Releases.IsEmpty() && s.Prereleases.IsEmpty()
+}
+
+// Equal reports whether both sets have the same versions.
+func (s VersionSet) Equal(other VersionSet) bool {
+	return s.Releases.Equal(other.Releases) && s.Prereleases.Equal(other.Prereleases)
+}
+
+// Intersect returns the versions in both sets.
+func (s VersionSet) Intersect(other VersionSet) VersionSet {
+	return VersionSet{
+		Releases:    releasesOf(s.Releases.Intersect(other.Releases)),
+		Prereleases: prereleasesOf(s.Prereleases.Intersect(other.Prereleases)),
+	}
+}
+
+// Union returns the versions in either set.
+func (s VersionSet) Union(other VersionSet) VersionSet {
+	return VersionSet{
+		Releases:    releasesOf(s.Releases.Union(other.Releases)),
+		Prereleases: prereleasesOf(s.Prereleases.Union(other.Prereleases)),
+	}
+}
+
+// Complement returns the versions not in the set.
+func (s VersionSet) Complement() VersionSet {
+	return VersionSet{
+		Releases:    releasesOf(s.Releases.Complement()),
+		Prereleases: prereleasesOf(s.Prereleases.Complement()),
+	}
+}
+
+// Difference returns the versions in the set but not in other.
+func (s VersionSet) Difference(other VersionSet) VersionSet {
+	return s.Intersect(other.Complement())
+}
+
+// IsSubset reports whether all versions of the set are in other.
+func (s VersionSet) IsSubset(other VersionSet) bool {
+	return s.Difference(other).IsEmpty()
+}
+
+// Witness returns a version in the set, preferring the lowest release.
+func (s VersionSet) Witness() (*SemVer, bool) {
+	if len(s.Releases) > 0 {
+		if s.Releases[0].Lower.Version == nil {
+			return newSemVer(0, 0, 0, ""), true
+		}
+
+		return s.Releases[0].Lower.Version, true
+	}
+
+	if len(s.Prereleases) > 0 {
+		if s.Prereleases[0].Lower.Version == nil {
+			return lowestVersion, true
+		}
+
+		return s.Prereleases[0].Lower.Version, true
+	}
+
+	return nil, false
+}
+
+// hull returns the versions by precedence between the bounds of the set.
+func (s VersionSet) hull() IntervalSet {
+	return s.Releases.Union(s.Prereleases)
+}
+
+// releasesOf returns the intervals bounded by the releases they hold.
+func releasesOf(intervals []Interval) IntervalSet {
+	lowest := newSemVer(0, 0, 0, "")
+	bounded := make([]Interval, 0, len(intervals))
+
+	for _, interval := range intervals {
+		lower := Bound{Version: lowest, Inclusive: true}
+		if ver := interval.Lower.Version; ver != nil {
+			lower.Version = newSemVer(ver.Major, ver.Minor, ver.Patch, "")
+			if ver.Prerelease == "" && !interval.Lower.Inclusive {
+				lower.Version = newSemVer(ver.Major, ver.Minor, ver.Patch+1, "")
+			}
+		}
+
+		var upper Bound
+		if ver := interval.Upper.Version; ver != nil {
+			upper.Version = newSemVer(ver.Major, ver.Minor, ver.Patch, "")
+			if ver.Prerelease == "" && interval.Upper.Inclusive {
+				upper.Version = newSemVer(ver.Major, ver.Minor, ver.Patch+1, "")
+			}
+		}
+
+		bounded = append(bounded, Interval{Lower: lower, Upper: upper})
+	}
+
+	set := NewIntervalSet(bounded...)
+	if len(set) > 0 && compareVersions(set[0].Lower.Version, lowest) == 0 {
+		set[0].Lower = Bound{}
+	}
+
+	return set
+}
+
+// prereleasesOf returns the intervals bounded by the prereleases they hold.
+func prereleasesOf(intervals []Interval) IntervalSet {
+	bounded := make([]Interval, 0, len(intervals))
+
+	for _, interval := range intervals {
+		var lower Bound
+		if ver := interval.Lower.Version; ver != nil {
+			lower = Bound{Version: ver, Inclusive: true}
+			if ver.Prerelease == "" || !interval.Lower.Inclusive {
+				lower.Version = successor(ver)
+			}
+		}
+
+		var upper Bound
+		if ver := interval.Upper.Version; ver != nil {
+			upper.Version = ver
+			if ver.Prerelease == "" || interval.Upper.Inclusive {
+				upper.Version = successor(ver)
+			}
+		}
+
+		bounded = append(bounded, Interval{Lower: lower, Upper: upper})
+	}
+
+	set := NewIntervalSet(bounded...)
+	if len(set) > 0 && set[0].Lower.Version != nil && compareVersions(set[0].Lower.Version, lowestVersion) == 0 {
+		set[0].Lower = Bound{}
+	}
+
+	return set
+}
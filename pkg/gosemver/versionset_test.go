@@ -0,0 +1,192 @@
+package gosemver_test
+
+import (
+	"testing"
+
+	"github.com/andreygrechin/gosemver/pkg/gosemver"
+)
+
+// probeVersions are releases and prereleases around the bounds used in the version set tests.
+var probeVersions = []string{
+	"0.0.0-0", "0.0.0", "0.9.0", "1.0.0-0", "1.0.0-rc.1", "1.0.0", "1.0.1-0", "1.0.1", "1.2.3-alpha",
+	"1.2.3-rc.1", "1.2.3-rc.2", "1.2.3", "1.2.4-0", "1.2.4", "1.5.0-beta", "1.5.0", "2.0.0-0",
+	"2.0.0-rc.1", "2.0.0", "2.0.1-0", "2.1.0", "3.0.0-rc.1", "3.0.0",
+}
+
+func mustConstraint(t *testing.T, constraint, syntax string) *gosemver.Constraint {
+	t.Helper()
+
+	c, err := gosemver.ParseConstraintSyntax(constraint, syntax)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c
+}
+
+func mustVersionSet(t *testing.T, constraint string) gosemver.VersionSet {
+	t.Helper()
+
+	return mustConstraint(t, constraint, gosemver.SyntaxNpm).VersionSet()
+}
+
+// checkProbes fails unless the set holds exactly the probe versions for which want is true.
+func checkProbes(t *testing.T, set gosemver.VersionSet, want func(ver *gosemver.SemVer) bool) {
+	t.Helper()
+
+	for _, probe := range probeVersions {
+		ver, err := gosemver.ParseSemVer(probe)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := set.Contains(ver); got != want(ver) {
+			t.Errorf("Contains(%s) = %v, want %v", probe, got, want(ver))
+		}
+	}
+}
+
+func TestConstraintVersionSet(t *testing.T) {
+	tests := []struct {
+		constraint string
+		syntax     string
+	}{
+		{">=1.0.0 <2.0.0", gosemver.SyntaxNpm},
+		{"^1.0.0", gosemver.SyntaxNpm},
+		{">=1.2.3-rc.1 <2.0.0", gosemver.SyntaxNpm},
+		{"1.2.3-rc.1 || >=1.0.0 <2.0.0", gosemver.SyntaxNpm},
+		{">1.2.3-alpha <=2.0.0-rc.1", gosemver.SyntaxNpm},
+		{"<1.2.3-rc.2", gosemver.SyntaxNpm},
+		{">1.2.3 <1.2.4-0", gosemver.SyntaxNpm},
+		{">1.2.3 <1.2.4", gosemver.SyntaxNpm},
+		{"*", gosemver.SyntaxNpm},
+		{"[1.0,2.0)", gosemver.SyntaxMaven},
+		{"(,1.0],[1.2.3-rc.1,)", gosemver.SyntaxMaven},
+		{"v1.2.3", gosemver.SyntaxGo},
+		{"~> 1.2", gosemver.SyntaxGem},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint, func(t *testing.T) {
+			c := mustConstraint(t, tt.constraint, tt.syntax)
+			checkProbes(t, c.VersionSet(), c.Check)
+		})
+	}
+}
+
+func TestVersionSetAlgebra(t *testing.T) {
+	tests := []struct {
+		name          string
+		a             string
+		b             string
+		wantIntersect string
+		wantUnion     string
+	}{
+		{"releases", "^1.2.0", ">=1.4.0 || ^2.0.0", ">=1.4.0 <2.0.0", ">=1.2.0"},
+		{"prerelease of a bound", "^1.2.3-rc.1", ">=1.0.0 <2.0.0", ">=1.2.3 <2.0.0", ">=1.0.0 <2.0.0 || >=1.2.3-rc.1 <1.2.3"},
+		{"exact prerelease", "1.2.3-rc.1", ">=1.0.0 <2.0.0", "<0.0.0-0", ">=1.0.0 <2.0.0 || =1.2.3-rc.1"},
+		{"prereleases only in common", "<1.0.0", ">=1.0.0-rc.1", "<0.0.0-0", "* || >=1.0.0-rc.1 <1.0.0"},
+		{"adjacent", "1.x", "2.x", "<0.0.0-0", ">=1.0.0 <3.0.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := mustConstraint(t, tt.a, gosemver.SyntaxNpm), mustConstraint(t, tt.b, gosemver.SyntaxNpm)
+
+			intersection := a.VersionSet().Intersect(b.VersionSet())
+			checkProbes(t, intersection, func(ver *gosemver.SemVer) bool { return a.Check(ver) && b.Check(ver) })
+
+			union := a.VersionSet().Union(b.VersionSet())
+			checkProbes(t, union, func(ver *gosemver.SemVer) bool { return a.Check(ver) || b.Check(ver) })
+
+			for _, result := range []struct {
+				set  gosemver.VersionSet
+				want string
+			}{{intersection, tt.wantIntersect}, {union, tt.wantUnion}} {
+				got, err := gosemver.FormatConstraint(result.set, gosemver.SyntaxNpm)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if got != result.want {
+					t.Errorf("FormatConstraint() = %q, want %q", got, result.want)
+				}
+
+				printed := mustConstraint(t, got, gosemver.SyntaxNpm)
+				checkProbes(t, result.set, printed.Check)
+			}
+		})
+	}
+}
+
+func TestVersionSetIsSubset(t *testing.T) {
+	tests := []struct {
+		name        string
+		a           string
+		b           string
+		want        bool
+		wantWitness string
+	}{
+		{"tilde in caret", "~1.2.3", "^1.0.0", true, ""},
+		{"caret in tilde", "^1.0.0", "~1.2.3", false, "1.0.0"},
+		{"equal", ">=1.0.0 <2.0.0", "^1.0.0", true, ""},
+		{"same releases", ">=1.0.0 <2.0.0-0", "1.x", true, ""},
+		{"exclusive bound", ">=1.0.0", ">1.0.0", false, "1.0.0"},
+		{"named prerelease", ">=1.0.0-rc.1 <1.0.0", ">=1.0.0", false, "1.0.0-rc.1"},
+		{"prerelease not named", ">=1.2.3-rc.1 <2.0.0", "^1.2.3 || >=1.2.3-rc.1 <1.2.3", true, ""},
+		{"after exclusive bound", "<1.0.0 || >2.0.0", "<1.0.0 || >=3.0.0", false, "2.0.1"},
+		{"nothing between bounds", ">1.2.3 <1.2.4-0", "1.0.0", true, ""},
+		{"empty", ">2.0.0 <1.0.0", "1.2.3", true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := mustConstraint(t, tt.a, gosemver.SyntaxNpm), mustConstraint(t, tt.b, gosemver.SyntaxNpm)
+			if got := a.VersionSet().IsSubset(b.VersionSet()); got != tt.want {
+				t.Errorf("IsSubset() = %v, want %v", got, tt.want)
+			}
+
+			witness, ok := a.VersionSet().Difference(b.VersionSet()).Witness()
+			if ok != (tt.wantWitness != "") || (ok && witness.String() != tt.wantWitness) {
+				t.Errorf("Witness() = %v, %v, want %q", witness, ok, tt.wantWitness)
+			}
+
+			if ok && (!a.Check(witness) || b.Check(witness)) {
+				t.Errorf("Witness() = %s, want a version satisfying %q but not %q", witness, tt.a, tt.b)
+			}
+		})
+	}
+}
+
+func TestVersionSetWitness(t *testing.T) {
+	tests := []struct {
+		constraint string
+		syntax     string
+		want       string
+	}{
+		{"^1.2.3", gosemver.SyntaxNpm, "1.2.3"},
+		{"<2.0.0", gosemver.SyntaxNpm, "0.0.0"},
+		{">=1.2.3-rc.1 <1.2.3", gosemver.SyntaxNpm, "1.2.3-rc.1"},
+		{">1.2.3-rc.1 <1.2.3", gosemver.SyntaxNpm, "1.2.3-rc.1.0"},
+		{"(,0.0.0]", gosemver.SyntaxMaven, "0.0.0"},
+		{"(1.0,1.1)", gosemver.SyntaxMaven, "1.0.1"},
+		{">1.2.3 <1.2.4", gosemver.SyntaxNpm, ""},
+		{">1.2.3 <1.2.4-0", gosemver.SyntaxNpm, ""},
+		{"(1.2.3,1.2.4-0)", gosemver.SyntaxMaven, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.constraint, func(t *testing.T) {
+			c := mustConstraint(t, tt.constraint, tt.syntax)
+
+			witness, ok := c.VersionSet().Witness()
+			if ok != (tt.want != "") || (ok && witness.String() != tt.want) {
+				t.Fatalf("Witness() = %v, %v, want %q", witness, ok, tt.want)
+			}
+
+			if ok && !c.Check(witness) {
+				t.Errorf("Witness() = %s does not satisfy %q", witness, tt.constraint)
+			}
+		})
+	}
+}